@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_applications" "mine" {
+  pov = "consumer"
+}
+
+locals {
+  application_ids = { for a in data.netorca_applications.mine.applications : a.name => a.id }
+}
+
+# Bootstrap the application only when the team doesn't have it yet.
+resource "netorca_application" "app8" {
+  count = contains(keys(local.application_ids), "app8") ? 0 : 1
+  name  = "app8"
+}
+
+output "cost_centres" {
+  value = { for a in data.netorca_applications.mine.applications_value : a.name => try(a.metadata.cost_centre, null) }
+}
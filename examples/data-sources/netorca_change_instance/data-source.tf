@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_change_instance" "example" {
+  pov = "serviceowner"
+  id  = 54
+}
+
+output "change_instance_state" {
+  value = data.netorca_change_instance.example.state
+}
+
+output "change_instance_declaration" {
+  value = data.netorca_change_instance.example.change_instance_value.service_item.declaration
+}
+
+# Apply only what changed in a MODIFY.
+output "change_instance_diff" {
+  value = data.netorca_change_instance.example.change_type == "MODIFY" ? jsondecode(data.netorca_change_instance.example.declaration_diff) : null
+}
+
+output "change_instance_new_zone" {
+  value = try(data.netorca_change_instance.example.new_declaration_value.zone, null)
+}
@@ -15,3 +15,22 @@ data "netorca_change_instances" "a_record_changes" {
 output "change_instance_ids" {
   value = [for i in data.netorca_change_instances.a_record_changes.change_instances : i.id]
 }
+
+# Pending changes to a_records in example.com or example.org with a short ttl.
+data "netorca_change_instances" "short_ttl_changes" {
+  pov = "serviceowner"
+  filters {
+    service_name = "a_record"
+    state        = "PENDING"
+  }
+  where {
+    path     = "service_item.declaration.zone"
+    operator = "in"
+    values   = ["example.com", "example.org"]
+  }
+  where {
+    path     = "service_item.declaration.ttl"
+    operator = "in"
+    values   = ["60", "120"]
+  }
+}
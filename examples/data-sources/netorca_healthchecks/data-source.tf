@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_healthchecks" "three_tier" {
+  pov          = "serviceowner"
+  service_name = "THREE_TIER_APPLICATION"
+}
+
+output "healthcheck_status_counts" {
+  value = data.netorca_healthchecks.three_tier.status_counts
+}
+
+output "failing_service_items" {
+  value = {
+    for h in data.netorca_healthchecks.three_tier.healthchecks : h.service_item_name => h.message
+    if h.status != 200
+  }
+}
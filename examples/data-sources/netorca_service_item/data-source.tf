@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_service_item" "by_id" {
+  pov = "serviceowner"
+  id  = 32
+}
+
+data "netorca_service_item" "by_name" {
+  pov            = "serviceowner"
+  service_name   = "THREE_TIER_APPLICATION"
+  application_id = 20
+  name           = "django-app6"
+}
+
+output "service_item_declaration" {
+  value = data.netorca_service_item.by_name.declaration
+}
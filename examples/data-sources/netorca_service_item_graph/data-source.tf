@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_service_item_graph" "vip" {
+  pov              = "serviceowner"
+  service_item_ids = [32]
+}
+
+# Pool members come before the VIP that depends on them.
+output "deployment_order" {
+  value = data.netorca_service_item_graph.vip.order
+}
+
+output "deployment_waves" {
+  value = { for n in data.netorca_service_item_graph.vip.nodes : n.level => n.name... }
+}
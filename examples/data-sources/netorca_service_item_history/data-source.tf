@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_service_item_history" "app" {
+  pov             = "consumer"
+  service_item_id = 31
+}
+
+# What changed in each submission, leaving out rejected versions.
+output "declaration_changes" {
+  value = {
+    for v in data.netorca_service_item_history.app.versions : v.commit_id => jsondecode(v.diff)
+    if v.state != "REJECTED"
+  }
+}
@@ -19,3 +19,23 @@ output "service_item_ids" {
 output "service_item_declarations" {
   value = [for i in data.netorca_service_items.service_items.completed_changes : i.declaration]
 }
+
+output "service_item_zones" {
+  value = [for i in data.netorca_service_items.completed_changes.service_items_value : i.declaration.zone]
+}
+
+# Every a_record item in zone example.com that has been given an address.
+data "netorca_service_items" "example_com_records" {
+  pov = "serviceowner"
+  filters {
+    service_name = "a_record"
+  }
+  where {
+    path  = "declaration.zone"
+    value = "example.com"
+  }
+  where {
+    path     = "deployed_item.address"
+    operator = "exists"
+  }
+}
@@ -0,0 +1,37 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_submission_validation" "app7" {
+  partial = true
+
+  declaration = jsonencode({
+    app7 = {
+      metadata = {}
+      services = {
+        a_record = [
+          {
+            name    = "www"
+            zone    = "example.com"
+            address = "10.0.0.10"
+          },
+        ]
+      }
+    }
+  })
+}
+
+# Fail a plan-only CI job when NetOrca would reject the declaration.
+check "declaration_valid" {
+  assert {
+    condition     = data.netorca_submission_validation.app7.valid
+    error_message = join("\n", [for e in data.netorca_submission_validation.app7.errors : "${e.path}: ${e.message}"])
+  }
+}
+
+output "planned_changes" {
+  value = [for c in data.netorca_submission_validation.app7.change_instances : "${c.change_type} ${c.service_name}/${c.service_item_name}"]
+}
@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+variable "commit_id" {
+  type = string
+}
+
+# Report what a release commit produced in NetOrca.
+data "netorca_submissions" "release" {
+  commit_id     = var.commit_id
+  created_after = "2026-10-01T00:00:00Z"
+}
+
+data "netorca_change_instance" "release" {
+  for_each = toset(flatten([for s in data.netorca_submissions.release.submissions : s.change_instance_ids]))
+  pov      = "consumer"
+  id       = each.value
+}
+
+output "release_outcome" {
+  value = {
+    for id, ci in data.netorca_change_instance.release : id => "${ci.change_type} ${ci.service_item.name}: ${ci.state}"
+  }
+}
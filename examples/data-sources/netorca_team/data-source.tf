@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_team" "alpha" {
+  pov       = "serviceowner"
+  team_type = "consumer"
+  name      = "alpha"
+}
+
+output "alpha_cost_centre" {
+  value = try(data.netorca_team.alpha.metadata_value.cost_centre, null)
+}
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_teams" "consumers" {
+  pov       = "serviceowner"
+  team_type = "consumer"
+}
+
+# Route approvals by the contacts recorded in each consumer team's metadata.
+output "approval_contacts" {
+  value = { for t in data.netorca_teams.consumers.teams_value : t.name => try(t.metadata.contacts, []) }
+}
@@ -13,7 +13,7 @@ data "netorca_change_instances" "a_records" {
 }
 
 resource "local_file" "test_file" {
-  for_each = { for i in data.netorca_change_instances.a_records.change_instances : jsondecode(i.service_item.declaration).name => jsondecode(i.service_item.declaration) }
+  for_each = { for i in data.netorca_change_instances.a_records.change_instances_value : i.service_item.declaration.name => i.service_item.declaration }
   content  = "Zone: ${each.value.zone}\nName: ${each.value.name}\nAddresses: ${join(",", each.value.addresses)}\n"
   filename = each.value.name
 }
@@ -22,10 +22,10 @@ resource "netorca_change_instances" "example" {
   depends_on = [
     local_file.test_file
   ]
-  for_each = { for i in data.netorca_change_instances.a_records.change_instances : i.id => i }
-  id       = each.value.id
-  state    = "COMPLETED"
-  pov      = "serviceowner"
+  for_each           = { for i in data.netorca_change_instances.a_records.change_instances : i.id => i }
+  change_instance_id = each.value.id
+  state              = "COMPLETED"
+  pov                = "serviceowner"
   deployed_item = jsonencode(
     {
       "deployed" : true,
@@ -0,0 +1,2 @@
+# Applications are imported using their NetOrca application ID.
+terraform import netorca_application.app7 7
@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+resource "netorca_application" "app7" {
+  name = "app7"
+  metadata = jsonencode({
+    cost_centre = "cc-12"
+    contact     = "alpha@example.com"
+  })
+}
+
+# Service items can be declared in the same configuration as their application.
+resource "netorca_service_item" "www" {
+  application_id = netorca_application.app7.application_id
+  service_name   = "a_record"
+  name           = "www"
+
+  declaration = jsonencode({
+    zone    = "example.com"
+    address = "10.0.0.10"
+  })
+}
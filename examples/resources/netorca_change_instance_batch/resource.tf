@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+# Approve every pending change instance of a service in one apply.
+resource "netorca_change_instance_batch" "approve" {
+  pov         = "serviceowner"
+  state       = "APPROVED"
+  description = "Approved in bulk by terraform"
+  deployed_item = jsonencode({
+    name     = "{{ service_item.declaration.name }}"
+    url      = "https://{{ service_item.name }}.example.com/"
+    deployed = true
+  })
+  max_concurrency = 10
+
+  filters {
+    service_id = 12
+    state      = "PENDING"
+  }
+
+  # Change the trigger to pick up change instances submitted since the last apply.
+  triggers = {
+    run = "2025-03-01"
+  }
+}
+
+output "failed_change_instances" {
+  value = [for r in netorca_change_instance_batch.approve.results : r if r.result == "failed"]
+}
@@ -0,0 +1,12 @@
+# Change instances are imported using {pov}/{change_instance_id}.
+terraform import 'netorca_change_instances.example["54"]' serviceowner/54
+
+# A bare change instance ID is imported from the serviceowner POV.
+terraform import netorca_change_instances.decommissioned 54
+
+# With Terraform 1.5+ an import block can generate the configuration:
+#   import {
+#     to = netorca_change_instances.imported
+#     id = "serviceowner/54"
+#   }
+# terraform plan -generate-config-out=generated.tf
@@ -13,7 +13,7 @@ data "netorca_change_instances" "change_instances" {
 }
 
 resource "local_file" "test_file" {
-  for_each = { for i in data.netorca_change_instances.change_instances.change_instances : jsondecode(i.service_item.declaration).name => jsondecode(i.service_item.declaration) }
+  for_each = { for i in data.netorca_change_instances.change_instances.change_instances_value : i.service_item.declaration.name => i.service_item.declaration }
   content  = "Zone: ${each.value.zone}\nName: ${each.value.name}\nAddresses: ${join(",", each.value.addresses)}\n"
   filename = each.value.name
 }
@@ -23,15 +23,54 @@ resource "netorca_change_instances" "example" {
   depends_on = [
     local_file.test_file
   ]
-  for_each = { for i in data.netorca_change_instances.change_instances.change_instances : i.id => i }
-  id       = each.value.id
-  state    = "APPROVED"
-  pov      = "serviceowner"
+  for_each           = { for i in data.netorca_change_instances.change_instances.change_instances : i.id => i }
+  change_instance_id = each.value.id
+  state              = "APPROVED"
+  pov                = "serviceowner"
+  description        = "Approved by terraform for ${each.value.service_item.name}"
   deployed_item = jsonencode(
     {
       "deployed" : true,
     }
   )
+
+  # Wait for the deployment pipeline to finish the change after it is approved.
+  wait_for_state = ["COMPLETED"]
+  timeouts {
+    create = "45m"
+    update = "45m"
+  }
+}
+
+# Mark the change instance ERROR when the automation stops managing it.
+resource "netorca_change_instances" "decommissioned" {
+  change_instance_id = 54
+  pov                = "serviceowner"
+  deployed_item      = jsonencode({ deployed = true })
+
+  on_destroy          = "set_state"
+  destroy_state       = "ERROR"
+  destroy_description = "Service no longer deployed by the automation"
+  destroy_deployed_item = jsonencode({
+    deployed = false
+  })
+}
+
+# Complete DELETE changes once the service is torn down, deployed_item is left out and cleared on completion.
+data "netorca_change_instances" "deletes" {
+  pov = "serviceowner"
+  filters {
+    service_id  = 12
+    change_type = "DELETE"
+  }
+}
+
+resource "netorca_change_instances" "retire" {
+  for_each           = { for i in data.netorca_change_instances.deletes.change_instances : i.id => i }
+  change_instance_id = each.value.id
+  state              = "COMPLETED"
+  pov                = "serviceowner"
+  description        = "Decommissioned ${each.value.service_item.name}"
 }
 
 output "change_instances" {
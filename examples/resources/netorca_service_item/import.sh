@@ -0,0 +1,2 @@
+# Service items are imported using their NetOrca service item ID.
+terraform import netorca_service_item.www 123
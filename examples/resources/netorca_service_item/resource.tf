@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+resource "netorca_service_item" "www" {
+  application_id = 19
+  service_name   = "a_record"
+  name           = "www"
+  commit_id      = "51e53e75292438c573f37152e1b831e4cd80bbc4"
+
+  declaration = jsonencode({
+    zone    = "example.com"
+    address = "10.0.0.10"
+    ttl     = 300
+  })
+
+  wait_for_completion = true
+
+  timeouts {
+    create = "1h"
+    update = "1h"
+  }
+}
+
+output "www_fqdn" {
+  value = netorca_service_item.www.deployed_item_value.fqdn
+}
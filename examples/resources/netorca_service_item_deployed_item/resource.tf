@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+data "netorca_service_item" "vip" {
+  pov            = "serviceowner"
+  service_name   = "load_balancer_vip"
+  application_id = 20
+  name           = "vip1"
+}
+
+resource "netorca_service_item_deployed_item" "vip" {
+  service_item_id = data.netorca_service_item.vip.id
+  deployed_item = jsonencode(
+    {
+      "ip" : "10.0.0.10",
+    }
+  )
+}
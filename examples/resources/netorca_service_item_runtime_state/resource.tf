@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+resource "netorca_service_item_runtime_state" "maintenance" {
+  service_item_id = 32
+  runtime_state   = "OUT_OF_SERVICE"
+}
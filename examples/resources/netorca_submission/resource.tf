@@ -0,0 +1,41 @@
+# Copyright (c) HashiCorp, Inc.
+
+provider "netorca" {
+  url    = "https://api.netorca.example.com"
+  apikey = "<netorca-api-key>"
+}
+
+variable "commit_id" {
+  type = string
+}
+
+resource "netorca_submission" "app7" {
+  commit_id = var.commit_id
+  partial   = true
+
+  declaration = jsonencode({
+    app7 = {
+      metadata = {
+        owner = "alpha"
+      }
+      services = {
+        a_record = [
+          {
+            name    = "www"
+            zone    = "example.com"
+            address = "10.0.0.10"
+          },
+          {
+            name    = "api"
+            zone    = "example.com"
+            address = "10.0.0.11"
+          },
+        ]
+      }
+    }
+  })
+}
+
+output "change_instance_ids" {
+  value = [for i in netorca_submission.app7.change_instances : i.id]
+}
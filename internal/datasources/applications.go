@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type applicationsDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type applicationsDataSourceData struct {
+	Pov          types.String `tfsdk:"pov"`
+	Name         types.String `tfsdk:"name"`
+	Owner        types.Int64  `tfsdk:"owner"`
+	Applications types.List   `tfsdk:"applications"`
+
+	ApplicationsValue types.Dynamic `tfsdk:"applications_value"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure = &applicationsDataSource{}
+)
+
+// NewApplicationsDataSource returns a new instance of applicationsDataSource.
+func NewApplicationsDataSource() datasource.DataSource {
+	return &applicationsDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *applicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+// Schema defines the schema for the data source.
+func (c *applicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return the NetOrca applications visible from a POV.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Returns only the application with this name.",
+				Optional:            true,
+			},
+			"owner": schema.Int64Attribute{
+				MarkdownDescription: "Returns only applications owned by the consumer team with this id.",
+				Optional:            true,
+			},
+			"applications_value": schema.DynamicAttribute{
+				MarkdownDescription: "The returned applications with their metadata decoded into objects, e.g. `applications_value[0].metadata.cost_centre`.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"applications": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"owner": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the consumer team that owns the application.",
+							Computed:            true,
+						},
+						"metadata": schema.StringAttribute{
+							MarkdownDescription: "The metadata of the application as a JSON string.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *applicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data applicationsDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := netorca.ApplicationQuery{
+		Pov:   data.Pov.ValueString(),
+		Name:  data.Name.ValueString(),
+		Owner: data.Owner.ValueInt64(),
+	}
+
+	applications, err := c.client.ApplicationsGet(&query)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error getting applications"), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d applications", len(applications)))
+
+	if applications == nil {
+		applications = []netorca.NetOrcaApplication{}
+	}
+
+	var diags diag.Diagnostics
+	elems := []attr.Value{}
+
+	for _, v := range applications {
+		obj, d := getTerraformApplication(v)
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: applicationAttrTypes}, elems)
+	diags.Append(d...)
+
+	applicationsValue, d := tfvalues.DynamicFromStruct(applications)
+	diags.Append(d...)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Applications = list
+	data.ApplicationsValue = applicationsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// getTerraformApplication converts an application into a Terraform object.
+func getTerraformApplication(v netorca.NetOrcaApplication) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metadata, err := json.Marshal(v.Metadata)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling metadata from application id: %d", v.Id), err.Error())
+		return types.ObjectNull(applicationAttrTypes), diags
+	}
+
+	obj, d := types.ObjectValue(applicationAttrTypes, map[string]attr.Value{
+		"id":       types.Int64Value(v.Id),
+		"name":     types.StringValue(v.Name),
+		"owner":    types.Int64Value(v.Owner),
+		"metadata": types.StringValue(string(metadata)),
+	})
+	diags.Append(d...)
+
+	return obj, diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var applicationAttrTypes = map[string]attr.Type{
+	"id":       types.Int64Type,
+	"name":     types.StringType,
+	"owner":    types.Int64Type,
+	"metadata": types.StringType,
+}
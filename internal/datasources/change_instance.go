@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type singleChangeInstanceDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type singleChangeInstanceDataSourceData struct {
+	Pov              types.String `tfsdk:"pov"`
+	Id               types.Int64  `tfsdk:"id"`
+	Url              types.String `tfsdk:"url"`
+	State            types.String `tfsdk:"state"`
+	Created          types.String `tfsdk:"created"`
+	Modified         types.String `tfsdk:"modified"`
+	Log              types.String `tfsdk:"log"`
+	ChangeType       types.String `tfsdk:"change_type"`
+	OldDeclaration   types.String `tfsdk:"old_declaration"`
+	NewDeclaration   types.String `tfsdk:"new_declaration"`
+	DeclarationDiff  types.String `tfsdk:"declaration_diff"`
+	Owner            types.Object `tfsdk:"owner"`
+	ConsumerTeam     types.Object `tfsdk:"consumer_team"`
+	ServiceOwnerTeam types.Object `tfsdk:"service_owner_team"`
+	Submission       types.Object `tfsdk:"submission"`
+	ServiceItem      types.Object `tfsdk:"service_item"`
+
+	OldDeclarationValue types.Dynamic `tfsdk:"old_declaration_value"`
+	NewDeclarationValue types.Dynamic `tfsdk:"new_declaration_value"`
+	ChangeInstanceValue types.Dynamic `tfsdk:"change_instance_value"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure = &singleChangeInstanceDataSource{}
+)
+
+// NewSingleChangeInstanceDataSource returns a new instance of singleChangeInstanceDataSource.
+func NewSingleChangeInstanceDataSource() datasource.DataSource {
+	return &singleChangeInstanceDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *singleChangeInstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_change_instance"
+}
+
+// Schema defines the schema for the data source.
+func (c *singleChangeInstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return a single change instance by id.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The id of the change instance.",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"created": schema.StringAttribute{
+				Computed: true,
+			},
+			"modified": schema.StringAttribute{
+				Computed: true,
+			},
+			"log": schema.StringAttribute{
+				MarkdownDescription: "The descriptions NetOrca recorded for the change instance.",
+				Computed:            true,
+			},
+			"change_type": schema.StringAttribute{
+				MarkdownDescription: "The type of change (CREATE|MODIFY|DELETE).",
+				Computed:            true,
+			},
+			"old_declaration": schema.StringAttribute{
+				MarkdownDescription: "The declaration before the change as a JSON string, null for a CREATE.",
+				Computed:            true,
+			},
+			"new_declaration": schema.StringAttribute{
+				MarkdownDescription: "The declaration after the change as a JSON string, null for a DELETE.",
+				Computed:            true,
+			},
+			"old_declaration_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `old_declaration` decoded into an object, e.g. `old_declaration_value.zone`. Null for a CREATE.",
+				Computed:            true,
+			},
+			"new_declaration_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `new_declaration` decoded into an object, e.g. `new_declaration_value.zone`. Null for a DELETE.",
+				Computed:            true,
+			},
+			"declaration_diff": schema.StringAttribute{
+				MarkdownDescription: "The JSON Patch (RFC 6902) operations that transform `old_declaration` into `new_declaration`, as a JSON string, e.g. to apply a MODIFY incrementally.",
+				Computed:            true,
+			},
+			"owner": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: changeInstanceOwnerAttrType,
+			},
+			"consumer_team": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: changeInstanceConsumerTeamAttrType,
+			},
+			"service_owner_team": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: changeInstanceServiceOwnerTeamAttrType,
+			},
+			"submission": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: changeInstanceSubmissionAttrType,
+			},
+			"service_item": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: serviceItemAttrType,
+			},
+			"change_instance_value": schema.DynamicAttribute{
+				MarkdownDescription: "The change instance with the service item `declaration`, `deployed_item` and metadata decoded into objects, e.g. `change_instance_value.service_item.declaration.zone`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *singleChangeInstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *singleChangeInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data singleChangeInstanceDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changeInstance, err := c.client.ChangeInstanceGetById(data.Id.ValueInt64(), data.Pov.ValueString())
+	if netorca.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Change instance not found",
+			fmt.Sprintf("Change instance id: %d doesn't exist or isn't visible from the %s POV.", data.Id.ValueInt64(), data.Pov.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", data.Id.ValueInt64()), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found change instance id: %d", changeInstance.Id))
+
+	resp.Diagnostics.Append(data.setChangeInstance(changeInstance)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// setChangeInstance populates the data source model from a netorca change instance.
+func (d *singleChangeInstanceDataSourceData) setChangeInstance(v netorca.ChangeInstance) diag.Diagnostics {
+	obj, diags := getTerraformChangeInstance(v)
+	if diags.HasError() {
+		return diags
+	}
+
+	serviceOwnerTeamObjVal, serviceOwnerTeamDiags := getTerraformChangeInstanceServiceOwnerTeam(v.ServiceOwnerTeam)
+	diags.Append(serviceOwnerTeamDiags...)
+
+	changeInstanceValue, valueDiags := tfvalues.DynamicFromStruct(v)
+	diags.Append(valueDiags...)
+
+	oldDeclarationValue, valueDiags := getTerraformDeclarationValue(v.OldDeclaration)
+	diags.Append(valueDiags...)
+
+	newDeclarationValue, valueDiags := getTerraformDeclarationValue(v.NewDeclaration)
+	diags.Append(valueDiags...)
+
+	d.Id = types.Int64Value(v.Id)
+	d.Url = types.StringValue(v.Url)
+	d.State = types.StringValue(v.State)
+	d.Created = types.StringValue(v.Created)
+	d.Modified = types.StringValue(v.Modified)
+	d.Log = types.StringValue(v.Log)
+	d.ChangeType = obj["change_type"].(types.String)
+	d.OldDeclaration = obj["old_declaration"].(types.String)
+	d.NewDeclaration = obj["new_declaration"].(types.String)
+	d.DeclarationDiff = obj["declaration_diff"].(types.String)
+	d.OldDeclarationValue = oldDeclarationValue
+	d.NewDeclarationValue = newDeclarationValue
+	d.Owner = obj["owner"].(types.Object)
+	d.ConsumerTeam = obj["consumer_team"].(types.Object)
+	d.ServiceOwnerTeam = serviceOwnerTeamObjVal
+	d.Submission = obj["submission"].(types.Object)
+	d.ServiceItem = obj["service_item"].(types.Object)
+	d.ChangeInstanceValue = changeInstanceValue
+
+	return diags
+}
+
+// getTerraformChangeInstanceServiceOwnerTeam converts the service owner team of a change instance into a Terraform
+// object.
+func getTerraformChangeInstanceServiceOwnerTeam(t netorca.ChangeInstanceServiceOwnerTeam) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metadata, err := json.Marshal(t.Metadata)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling service owner team metadata"), err.Error())
+		return types.ObjectNull(changeInstanceServiceOwnerTeamAttrType), diags
+	}
+
+	obj := map[string]attr.Value{
+		"id":       types.Int64Value(t.Id),
+		"name":     types.StringValue(t.Name),
+		"metadata": types.StringValue(string(metadata)),
+	}
+	objVal, d := types.ObjectValue(changeInstanceServiceOwnerTeamAttrType, obj)
+	diags.Append(d...)
+
+	return objVal, diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var changeInstanceServiceOwnerTeamAttrType = map[string]attr.Type{
+	"id":       types.Int64Type,
+	"name":     types.StringType,
+	"metadata": types.StringType,
+}
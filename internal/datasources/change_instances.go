@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+	"terraform-provider-netorca/internal/where"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -27,10 +29,12 @@ type changeInstanceDataSource struct {
 }
 
 type changeInstanceDataSourceData struct {
-	Pov                 types.String `tfsdk:"pov"`
-	ChangeInstanceCount types.Int64  `tfsdk:"change_instance_count"`
-	ChangeInstances     types.List   `tfsdk:"change_instances"`
-	Filters             types.Object `tfsdk:"filters"`
+	Pov                  types.String  `tfsdk:"pov"`
+	ChangeInstanceCount  types.Int64   `tfsdk:"change_instance_count"`
+	ChangeInstances      types.List    `tfsdk:"change_instances"`
+	ChangeInstancesValue types.Dynamic `tfsdk:"change_instances_value"`
+	Filters              types.Object  `tfsdk:"filters"`
+	Where                types.List    `tfsdk:"where"`
 
 	// internal field to hold the parsed filters.
 	filters *changeInstanceDataSourceFiltersData `tfsdk:"-"`
@@ -84,12 +88,19 @@ func (c *changeInstanceDataSource) Schema(ctx context.Context, req datasource.Sc
 				Required:    true,
 			},
 			"change_instance_count": schema.Int64Attribute{
-				Description: "The number of change instances that the request has matched.",
+				Description: "The number of change instances that the request has matched, or the number left after applying `where`.",
 				Computed:    true,
 			},
+			"change_instances_value": schema.DynamicAttribute{
+				MarkdownDescription: "The returned change instances with the service item `declaration`, `deployed_item` and metadata decoded into objects, e.g. `change_instances_value[0].service_item.declaration.zone`.",
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"where": whereBlock("service_item.declaration.zone"),
 			"change_instances": schema.ListNestedBlock{
+				MarkdownDescription: "The returned change instances. Each change instance is at the same index in `change_instances_value`, where its json attributes are decoded, " +
+					"e.g. `change_instances_value[0].service_item.declaration.zone` for `change_instances[0].service_item.declaration`.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int64Attribute{
@@ -107,6 +118,22 @@ func (c *changeInstanceDataSource) Schema(ctx context.Context, req datasource.Sc
 						"modified": schema.StringAttribute{
 							Computed: true,
 						},
+						"change_type": schema.StringAttribute{
+							MarkdownDescription: "The type of change (CREATE|MODIFY|DELETE).",
+							Computed:            true,
+						},
+						"old_declaration": schema.StringAttribute{
+							MarkdownDescription: "The declaration before the change as a JSON string, null for a CREATE. Decoded in `change_instances_value[n].old_declaration.declaration`.",
+							Computed:            true,
+						},
+						"new_declaration": schema.StringAttribute{
+							MarkdownDescription: "The declaration after the change as a JSON string, null for a DELETE. Decoded in `change_instances_value[n].new_declaration.declaration`.",
+							Computed:            true,
+						},
+						"declaration_diff": schema.StringAttribute{
+							MarkdownDescription: "The JSON Patch (RFC 6902) operations that transform `old_declaration` into `new_declaration`, as a JSON string.",
+							Computed:            true,
+						},
 						"owner": schema.ObjectAttribute{
 							Computed:       true,
 							AttributeTypes: changeInstanceOwnerAttrType,
@@ -120,8 +147,9 @@ func (c *changeInstanceDataSource) Schema(ctx context.Context, req datasource.Sc
 							AttributeTypes: changeInstanceSubmissionAttrType,
 						},
 						"service_item": schema.ObjectAttribute{
-							Computed:       true,
-							AttributeTypes: serviceItemAttrType,
+							MarkdownDescription: "The service item of the change instance, its json attributes are decoded in `change_instances_value[n].service_item`.",
+							Computed:            true,
+							AttributeTypes:      serviceItemAttrType,
 						},
 					},
 				},
@@ -204,12 +232,17 @@ func (c *changeInstanceDataSource) Read(ctx context.Context, req datasource.Read
 		diags = data.extractFilters(ctx)
 		resp.Diagnostics.Append(diags...)
 	}
-	data.filters.pov = data.Pov
+
+	conditions, diags := getWhereConditions(ctx, data.Where)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Build query map based on filters.
 	queryMap := make(map[string]interface{})
+	queryMap["pov"] = data.Pov.ValueString()
 	if data.filters != nil {
-		queryMap["pov"] = data.filters.pov.ValueString()
 		queryMap["application_id"] = data.filters.ApplicationId.ValueInt64()
 		queryMap["change_type"] = data.filters.ChangeType.ValueString()
 		queryMap["commit_id"] = data.filters.CommitId.ValueString()
@@ -231,19 +264,45 @@ func (c *changeInstanceDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	changeInstancesRaw, err := c.client.ChangeInstanceGet(query)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintln("Error getting change instances"), err.Error())
-		return
+	var changeInstancesRaw []netorca.ChangeInstance
+	var changeInstanceCount int64
+	if len(conditions) > 0 {
+		// The conditions are evaluated by the provider, so every page is read for them to see every match.
+		changeInstancesRaw, err = c.client.ChangeInstanceGetAll(query)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintln("Error getting change instances"), err.Error())
+			return
+		}
+
+		changeInstancesRaw, err = where.Filter(changeInstancesRaw, conditions)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintln("Error evaluating where conditions on change instances"), err.Error())
+			return
+		}
+		changeInstanceCount = int64(len(changeInstancesRaw))
+	} else {
+		page, err := c.client.ChangeInstanceGet(query)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintln("Error getting change instances"), err.Error())
+			return
+		}
+
+		changeInstancesRaw = page.Results
+		changeInstanceCount = int64(page.Count)
 	}
 
-	changeInstances, err := getTerraformChangeInstances(changeInstancesRaw.Results, resp)
-	if err != nil {
+	changeInstances, diags := getTerraformChangeInstances(changeInstancesRaw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.ChangeInstances = changeInstances
-	data.ChangeInstanceCount = types.Int64Value(int64(changeInstancesRaw.Count))
+
+	changeInstancesValue, diags := tfvalues.DynamicFromStruct(changeInstancesRaw)
+	resp.Diagnostics.Append(diags...)
+	data.ChangeInstancesValue = changeInstancesValue
+	data.ChangeInstanceCount = types.Int64Value(changeInstanceCount)
 	tflog.Trace(ctx, "Read a data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -261,107 +320,18 @@ func (c *changeInstanceDataSourceData) extractFilters(ctx context.Context) diag.
 }
 
 // getTerraformChangeInstances converts netorca change instances into a Terraform list.
-func getTerraformChangeInstances(changeInstances []netorca.ChangeInstance, resp *datasource.ReadResponse) (types.List, error) {
+func getTerraformChangeInstances(changeInstances []netorca.ChangeInstance) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	elemType := types.ObjectType{AttrTypes: changeInstanceAttrTypes}
 	elems := []attr.Value{}
 
 	for _, v := range changeInstances {
-		ownerObj := map[string]attr.Value{
-			"id":   types.Int64Value(v.Owner.Id),
-			"name": types.StringValue(v.Owner.Name),
-		}
-		ownerObjVal, ownerDiags := types.ObjectValue(changeInstanceOwnerAttrType, ownerObj)
-		diags.Append(ownerDiags...)
-
-		submissionObj := map[string]attr.Value{
-			"id":        types.Int64Value(v.Submission.Id),
-			"commit_id": types.StringValue(v.Submission.CommitId),
-		}
-		submissionObjVal, submissionDiags := types.ObjectValue(changeInstanceSubmissionAttrType, submissionObj)
-		diags.Append(submissionDiags...)
-
-		metadata, err := json.Marshal(v.ConsumerTeam.Metadata)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling consumer team metadata"), err.Error())
-			return types.ListNull(elemType), err
-		}
-		consumerTeamObj := map[string]attr.Value{
-			"id":       types.Int64Value(v.ConsumerTeam.Id),
-			"name":     types.StringValue(v.ConsumerTeam.Name),
-			"metadata": types.StringValue(string(metadata)),
-		}
-		consumerTeamObjVal, consumerTeamDiags := types.ObjectValue(changeInstanceConsumerTeamAttrType, consumerTeamObj)
-		diags.Append(consumerTeamDiags...)
-
-		// Service Item related conversions.
-		serviceItemApplicationMetadata, err := json.Marshal(v.ServiceItemField.Application.Metadata)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling service_item.application.metadata"), err.Error())
-			return types.ListNull(elemType), err
-		}
-		serviceItemApplicationObj := map[string]attr.Value{
-			"id":       types.Int64Value(int64(v.ServiceItemField.Application.Id)),
-			"name":     types.StringValue(v.ServiceItemField.Application.Name),
-			"metadata": types.StringValue(string(serviceItemApplicationMetadata)),
-			"owner":    types.Int64Value(int64(v.ServiceItemField.Application.Owner)),
-		}
-		serviceItemApplicationObjVal, serviceItemApplicationDiags := types.ObjectValue(serviceItemApplicationAttrType, serviceItemApplicationObj)
-		diags.Append(serviceItemApplicationDiags...)
-
-		serviceItemDeclaration, err := json.Marshal(v.ServiceItemField.Declaration)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling service item declaration"), err.Error())
-			return types.ListNull(elemType), err
-		}
-		serviceItemOwnerObj := map[string]attr.Value{
-			"id":   types.Int64Value(int64(v.ServiceItemField.Service.Owner.Id)),
-			"name": types.StringValue(v.ServiceItemField.Service.Owner.Name),
-		}
-		serviceItemOwnerObjVal, serviceItemOwnerDiags := types.ObjectValue(serviceItemOwnerAttrType, serviceItemOwnerObj)
-		diags.Append(serviceItemOwnerDiags...)
-
-		serviceItemServiceObj := map[string]attr.Value{
-			"id":          types.Int64Value(int64(v.ServiceItemField.Service.Id)),
-			"name":        types.StringValue(v.ServiceItemField.Service.Name),
-			"owner":       types.Object(serviceItemOwnerObjVal),
-			"healthcheck": types.BoolValue(v.ServiceItemField.Service.HealthCheck),
-		}
-		serviceItemServiceObjVal, serviceItemServiceDiags := types.ObjectValue(serviceItemServiceAttrType, serviceItemServiceObj)
-		diags.Append(serviceItemServiceDiags...)
-
-		deployedItemData, err := json.Marshal(v.ServiceItemField.DeployedItem)
-		if err != nil {
-			return types.ListNull(elemType), err
+		obj, d := getTerraformChangeInstance(v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
 		}
 
-		serviceItemObj := map[string]attr.Value{
-			"id":            types.Int64Value(int64(v.ServiceItemField.Id)),
-			"url":           types.StringValue(v.ServiceItemField.Url),
-			"name":          types.StringValue(v.ServiceItemField.Name),
-			"created":       types.StringValue(v.ServiceItemField.Created),
-			"modified":      types.StringValue(v.ServiceItemField.Modified),
-			"runtime_state": types.StringValue(v.ServiceItemField.RuntimeState),
-			"change_state":  types.StringValue(v.ServiceItemField.ChangeState),
-			"service":       types.Object(serviceItemServiceObjVal),
-			"application":   types.Object(serviceItemApplicationObjVal),
-			"declaration":   types.StringValue(string(serviceItemDeclaration)),
-			"deployed_item": types.StringValue(string(deployedItemData)),
-		}
-		serviceItemObjVal, serviceItemDiags := types.ObjectValue(serviceItemAttrType, serviceItemObj)
-		diags.Append(serviceItemDiags...)
-
-		obj := map[string]attr.Value{
-			"id":            types.Int64Value(v.Id),
-			"url":           types.StringValue(v.Url),
-			"state":         types.StringValue(v.State),
-			"created":       types.StringValue(v.Created),
-			"modified":      types.StringValue(v.Modified),
-			"owner":         types.Object(ownerObjVal),
-			"consumer_team": types.Object(consumerTeamObjVal),
-			"submission":    types.Object(submissionObjVal),
-			"service_item":  types.Object(serviceItemObjVal),
-		}
 		objVal, d := types.ObjectValue(changeInstanceAttrTypes, obj)
 		diags.Append(d...)
 		elems = append(elems, objVal)
@@ -369,8 +339,156 @@ func getTerraformChangeInstances(changeInstances []netorca.ChangeInstance, resp
 
 	listVal, d := types.ListValue(elemType, elems)
 	diags.Append(d...)
-	resp.Diagnostics.Append(d...)
-	return listVal, nil
+	return listVal, diags
+}
+
+// getTerraformChangeInstance converts a netorca change instance into the attribute values of a change instance object.
+func getTerraformChangeInstance(v netorca.ChangeInstance) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ownerObj := map[string]attr.Value{
+		"id":   types.Int64Value(v.Owner.Id),
+		"name": types.StringValue(v.Owner.Name),
+	}
+	ownerObjVal, ownerDiags := types.ObjectValue(changeInstanceOwnerAttrType, ownerObj)
+	diags.Append(ownerDiags...)
+
+	submissionObj := map[string]attr.Value{
+		"id":        types.Int64Value(v.Submission.Id),
+		"commit_id": types.StringValue(v.Submission.CommitId),
+	}
+	submissionObjVal, submissionDiags := types.ObjectValue(changeInstanceSubmissionAttrType, submissionObj)
+	diags.Append(submissionDiags...)
+
+	metadata, err := json.Marshal(v.ConsumerTeam.Metadata)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling consumer team metadata"), err.Error())
+		return nil, diags
+	}
+	consumerTeamObj := map[string]attr.Value{
+		"id":       types.Int64Value(v.ConsumerTeam.Id),
+		"name":     types.StringValue(v.ConsumerTeam.Name),
+		"metadata": types.StringValue(string(metadata)),
+	}
+	consumerTeamObjVal, consumerTeamDiags := types.ObjectValue(changeInstanceConsumerTeamAttrType, consumerTeamObj)
+	diags.Append(consumerTeamDiags...)
+
+	// Service Item related conversions.
+	serviceItemApplicationMetadata, err := json.Marshal(v.ServiceItemField.Application.Metadata)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling service_item.application.metadata"), err.Error())
+		return nil, diags
+	}
+	serviceItemApplicationObj := map[string]attr.Value{
+		"id":       types.Int64Value(int64(v.ServiceItemField.Application.Id)),
+		"name":     types.StringValue(v.ServiceItemField.Application.Name),
+		"metadata": types.StringValue(string(serviceItemApplicationMetadata)),
+		"owner":    types.Int64Value(int64(v.ServiceItemField.Application.Owner)),
+	}
+	serviceItemApplicationObjVal, serviceItemApplicationDiags := types.ObjectValue(serviceItemApplicationAttrType, serviceItemApplicationObj)
+	diags.Append(serviceItemApplicationDiags...)
+
+	serviceItemDeclaration, err := json.Marshal(v.ServiceItemField.Declaration)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling service item declaration"), err.Error())
+		return nil, diags
+	}
+	serviceItemOwnerObj := map[string]attr.Value{
+		"id":   types.Int64Value(int64(v.ServiceItemField.Service.Owner.Id)),
+		"name": types.StringValue(v.ServiceItemField.Service.Owner.Name),
+	}
+	serviceItemOwnerObjVal, serviceItemOwnerDiags := types.ObjectValue(serviceItemOwnerAttrType, serviceItemOwnerObj)
+	diags.Append(serviceItemOwnerDiags...)
+
+	serviceItemServiceObj := map[string]attr.Value{
+		"id":          types.Int64Value(int64(v.ServiceItemField.Service.Id)),
+		"name":        types.StringValue(v.ServiceItemField.Service.Name),
+		"owner":       types.Object(serviceItemOwnerObjVal),
+		"healthcheck": types.BoolValue(v.ServiceItemField.Service.HealthCheck),
+	}
+	serviceItemServiceObjVal, serviceItemServiceDiags := types.ObjectValue(serviceItemServiceAttrType, serviceItemServiceObj)
+	diags.Append(serviceItemServiceDiags...)
+
+	deployedItemData, err := json.Marshal(v.ServiceItemField.DeployedItem)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling service item deployed_item"), err.Error())
+		return nil, diags
+	}
+
+	serviceItemObj := map[string]attr.Value{
+		"id":            types.Int64Value(int64(v.ServiceItemField.Id)),
+		"url":           types.StringValue(v.ServiceItemField.Url),
+		"name":          types.StringValue(v.ServiceItemField.Name),
+		"created":       types.StringValue(v.ServiceItemField.Created),
+		"modified":      types.StringValue(v.ServiceItemField.Modified),
+		"runtime_state": types.StringValue(v.ServiceItemField.RuntimeState),
+		"change_state":  types.StringValue(v.ServiceItemField.ChangeState),
+		"service":       types.Object(serviceItemServiceObjVal),
+		"application":   types.Object(serviceItemApplicationObjVal),
+		"declaration":   types.StringValue(string(serviceItemDeclaration)),
+		"deployed_item": types.StringValue(string(deployedItemData)),
+	}
+	serviceItemObjVal, serviceItemDiags := types.ObjectValue(serviceItemAttrType, serviceItemObj)
+	diags.Append(serviceItemDiags...)
+
+	oldDeclaration, err := getTerraformDeclaration(v.OldDeclaration)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling old_declaration"), err.Error())
+		return nil, diags
+	}
+
+	newDeclaration, err := getTerraformDeclaration(v.NewDeclaration)
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling new_declaration"), err.Error())
+		return nil, diags
+	}
+
+	declarationDiff, err := json.Marshal(v.DeclarationDiff())
+	if err != nil {
+		diags.AddError(fmt.Sprintln("Error Marshalling declaration_diff"), err.Error())
+		return nil, diags
+	}
+
+	obj := map[string]attr.Value{
+		"id":               types.Int64Value(v.Id),
+		"url":              types.StringValue(v.Url),
+		"state":            types.StringValue(v.State),
+		"created":          types.StringValue(v.Created),
+		"modified":         types.StringValue(v.Modified),
+		"change_type":      types.StringValue(v.ChangeType),
+		"old_declaration":  oldDeclaration,
+		"new_declaration":  newDeclaration,
+		"declaration_diff": types.StringValue(string(declarationDiff)),
+		"owner":            types.Object(ownerObjVal),
+		"consumer_team":    types.Object(consumerTeamObjVal),
+		"submission":       types.Object(submissionObjVal),
+		"service_item":     types.Object(serviceItemObjVal),
+	}
+
+	return obj, diags
+}
+
+// getTerraformDeclaration converts a change instance declaration into a JSON string, null when there is none.
+func getTerraformDeclaration(d *netorca.ChangeInstanceDeclaration) (types.String, error) {
+	if d == nil {
+		return types.StringNull(), nil
+	}
+
+	b, err := json.Marshal(d.Declaration)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(string(b)), nil
+}
+
+// getTerraformDeclarationValue converts a change instance declaration into a dynamic value, null when there is none.
+func getTerraformDeclarationValue(d *netorca.ChangeInstanceDeclaration) (types.Dynamic, diag.Diagnostics) {
+	if d == nil {
+		return types.DynamicNull(), nil
+	}
+
+	return tfvalues.DynamicFromStruct(d.Declaration)
 }
 
 // -----------------------------------------------------------------------------
@@ -383,6 +501,12 @@ var changeInstanceAttrTypes = map[string]attr.Type{
 	"state":    types.StringType,
 	"created":  types.StringType,
 	"modified": types.StringType,
+
+	"change_type":      types.StringType,
+	"old_declaration":  types.StringType,
+	"new_declaration":  types.StringType,
+	"declaration_diff": types.StringType,
+
 	"owner": types.ObjectType{
 		AttrTypes: changeInstanceOwnerAttrType,
 	},
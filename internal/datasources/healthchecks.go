@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type healthchecksDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type healthchecksDataSourceData struct {
+	Pov                types.String `tfsdk:"pov"`
+	ServiceId          types.Int64  `tfsdk:"service_id"`
+	ServiceName        types.String `tfsdk:"service_name"`
+	ConsumerTeamId     types.Int64  `tfsdk:"consumer_team_id"`
+	ServiceOwnerTeamId types.Int64  `tfsdk:"service_owner_team_id"`
+	LatestOnly         types.Bool   `tfsdk:"latest_only"`
+	HealthcheckCount   types.Int64  `tfsdk:"healthcheck_count"`
+	StatusCounts       types.Map    `tfsdk:"status_counts"`
+	Healthchecks       types.List   `tfsdk:"healthchecks"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure      = &healthchecksDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &healthchecksDataSource{}
+)
+
+// NewHealthchecksDataSource returns a new instance of healthchecksDataSource.
+func NewHealthchecksDataSource() datasource.DataSource {
+	return &healthchecksDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *healthchecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_healthchecks"
+}
+
+// Schema defines the schema for the data source.
+func (c *healthchecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return the healthcheck results of the service items of a service or a team, with the number of results per status.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+			},
+			"service_id": schema.Int64Attribute{
+				MarkdownDescription: "Returns only healthchecks of service items of the service with this id.",
+				Optional:            true,
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "Returns only healthchecks of service items of the service with this name.",
+				Optional:            true,
+			},
+			"consumer_team_id": schema.Int64Attribute{
+				MarkdownDescription: "Returns only healthchecks of service items requested by this consumer team.",
+				Optional:            true,
+			},
+			"service_owner_team_id": schema.Int64Attribute{
+				MarkdownDescription: "Returns only healthchecks of service items owned by this service owner team.",
+				Optional:            true,
+			},
+			"latest_only": schema.BoolAttribute{
+				MarkdownDescription: "Only return the most recent healthcheck of each service item. Defaults to true.",
+				Optional:            true,
+			},
+			"healthcheck_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of healthchecks returned.",
+				Computed:            true,
+			},
+			"status_counts": schema.MapAttribute{
+				MarkdownDescription: "The number of healthchecks returned for each status, keyed by the status.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"healthchecks": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"service_item_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"service_item_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.Int64Attribute{
+							MarkdownDescription: "The status reported by the healthcheck.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The message reported by the healthcheck.",
+							Computed:            true,
+						},
+						"last_checked": schema.StringAttribute{
+							MarkdownDescription: "When the healthcheck was reported.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *healthchecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// ValidateConfig ensures the healthchecks are scoped to a service or a team.
+func (c *healthchecksDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data healthchecksDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation until all scope values are known.
+	if data.ServiceId.IsUnknown() || data.ServiceName.IsUnknown() || data.ConsumerTeamId.IsUnknown() || data.ServiceOwnerTeamId.IsUnknown() {
+		return
+	}
+
+	if data.ServiceId.IsNull() && data.ServiceName.IsNull() && data.ConsumerTeamId.IsNull() && data.ServiceOwnerTeamId.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing healthcheck scope",
+			"Set at least one of service_id, service_name, consumer_team_id or service_owner_team_id.",
+		)
+	}
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *healthchecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data healthchecksDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := netorca.HealthcheckQuery{
+		Pov:                data.Pov.ValueString(),
+		ServiceId:          data.ServiceId.ValueInt64(),
+		ServiceName:        data.ServiceName.ValueString(),
+		ConsumerTeamId:     data.ConsumerTeamId.ValueInt64(),
+		ServiceOwnerTeamId: data.ServiceOwnerTeamId.ValueInt64(),
+	}
+
+	healthchecks, err := c.client.HealthchecksGet(&query)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error getting healthchecks"), err.Error())
+		return
+	}
+
+	if data.LatestOnly.IsNull() || data.LatestOnly.ValueBool() {
+		healthchecks = netorca.LatestHealthchecks(healthchecks)
+	}
+
+	var diags diag.Diagnostics
+	data.Healthchecks, diags = getTerraformHealthchecks(healthchecks)
+	resp.Diagnostics.Append(diags...)
+
+	data.StatusCounts, diags = getTerraformHealthcheckStatusCounts(healthchecks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.HealthcheckCount = types.Int64Value(int64(len(healthchecks)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// getTerraformHealthchecks converts netorca healthchecks into a Terraform list.
+func getTerraformHealthchecks(healthchecks []netorca.Healthcheck) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: healthcheckAttrTypes}
+	elems := []attr.Value{}
+
+	for _, v := range healthchecks {
+		obj := map[string]attr.Value{
+			"id":                types.Int64Value(v.Id),
+			"service_item_id":   types.Int64Value(v.ServiceItem.Id),
+			"service_item_name": types.StringValue(v.ServiceItem.Name),
+			"service_name":      types.StringValue(v.ServiceItem.ServiceName),
+			"status":            types.Int64Value(v.Status),
+			"message":           types.StringValue(v.Message),
+			"last_checked":      types.StringValue(v.Created),
+		}
+		objVal, d := types.ObjectValue(healthcheckAttrTypes, obj)
+		diags.Append(d...)
+		elems = append(elems, objVal)
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+// getTerraformHealthcheckStatusCounts converts the number of healthchecks per status into a Terraform map.
+func getTerraformHealthcheckStatusCounts(healthchecks []netorca.Healthcheck) (types.Map, diag.Diagnostics) {
+	counts := map[string]attr.Value{}
+	for status, count := range netorca.CountHealthchecksByStatus(healthchecks) {
+		counts[status] = types.Int64Value(count)
+	}
+
+	return types.MapValue(types.Int64Type, counts)
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var healthcheckAttrTypes = map[string]attr.Type{
+	"id":                types.Int64Type,
+	"service_item_id":   types.Int64Type,
+	"service_item_name": types.StringType,
+	"service_name":      types.StringType,
+	"status":            types.Int64Type,
+	"message":           types.StringType,
+	"last_checked":      types.StringType,
+}
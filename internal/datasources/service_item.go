@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type singleServiceItemDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type singleServiceItemDataSourceData struct {
+	Pov               types.String `tfsdk:"pov"`
+	Id                types.Int64  `tfsdk:"id"`
+	ServiceName       types.String `tfsdk:"service_name"`
+	ApplicationId     types.Int64  `tfsdk:"application_id"`
+	Name              types.String `tfsdk:"name"`
+	Url               types.String `tfsdk:"url"`
+	Created           types.String `tfsdk:"created"`
+	Modified          types.String `tfsdk:"modified"`
+	RuntimeState      types.String `tfsdk:"runtime_state"`
+	ChangeState       types.String `tfsdk:"change_state"`
+	Service           types.Object `tfsdk:"service"`
+	Application       types.Object `tfsdk:"application"`
+	DeployedItem      types.String `tfsdk:"deployed_item"`
+	ConsumerTeam      types.Object `tfsdk:"consumer_team"`
+	ServiceOwnerTeam  types.Object `tfsdk:"service_owner_team"`
+	Declaration       types.String `tfsdk:"declaration"`
+	Related           types.List   `tfsdk:"related"`
+	HealthcheckStatus types.Int64  `tfsdk:"healthcheck_status"`
+
+	DeclarationValue          types.Dynamic `tfsdk:"declaration_value"`
+	DeployedItemValue         types.Dynamic `tfsdk:"deployed_item_value"`
+	ApplicationMetadataValue  types.Dynamic `tfsdk:"application_metadata_value"`
+	ConsumerTeamMetadataValue types.Dynamic `tfsdk:"consumer_team_metadata_value"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure      = &singleServiceItemDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &singleServiceItemDataSource{}
+)
+
+// NewSingleServiceItemDataSource returns a new instance of singleServiceItemDataSource.
+func NewSingleServiceItemDataSource() datasource.DataSource {
+	return &singleServiceItemDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *singleServiceItemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_item"
+}
+
+// Schema defines the schema for the data source.
+func (c *singleServiceItemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return a single service item, looked up either by id or by service_name, application_id and name.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The id of the service item. Conflicts with `service_name`, `application_id` and `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service the item belongs to. Must be set together with `application_id` and `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"application_id": schema.Int64Attribute{
+				MarkdownDescription: "The id of the application the item belongs to. Must be set together with `service_name` and `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service item. Must be set together with `service_name` and `application_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+			},
+			"created": schema.StringAttribute{
+				Computed: true,
+			},
+			"modified": schema.StringAttribute{
+				Computed: true,
+			},
+			"runtime_state": schema.StringAttribute{
+				Computed: true,
+			},
+			"change_state": schema.StringAttribute{
+				Computed: true,
+			},
+			"service": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: serviceItemServiceAttrType,
+			},
+			"application": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: serviceItemApplicationAttrType,
+			},
+			"deployed_item": schema.StringAttribute{
+				Computed: true,
+			},
+			"consumer_team": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: serviceItemConsumerTeamAttrTypes,
+			},
+			"service_owner_team": schema.ObjectAttribute{
+				Computed:       true,
+				AttributeTypes: serviceItemServiceOwnerTeamAttrTypes,
+			},
+			"declaration": schema.StringAttribute{
+				Computed: true,
+			},
+			"related": schema.ListAttribute{
+				MarkdownDescription: "The service items this service item depends on.",
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: serviceItemRelatedAttrTypes},
+			},
+			"healthcheck_status": schema.Int64Attribute{
+				Computed: true,
+			},
+			"declaration_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `declaration` decoded into an object, e.g. `declaration_value.zone`.",
+				Computed:            true,
+			},
+			"deployed_item_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `deployed_item` decoded into an object.",
+				Computed:            true,
+			},
+			"application_metadata_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `application.metadata` decoded into an object.",
+				Computed:            true,
+			},
+			"consumer_team_metadata_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `consumer_team.metadata` decoded into an object.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *singleServiceItemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// ValidateConfig ensures the service item is looked up either by id or by the composite key, but not both.
+func (c *singleServiceItemDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data singleServiceItemDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation until all lookup values are known.
+	if data.Id.IsUnknown() || data.ServiceName.IsUnknown() || data.ApplicationId.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	compositeKeySet := !data.ServiceName.IsNull() || !data.ApplicationId.IsNull() || !data.Name.IsNull()
+
+	if !data.Id.IsNull() && compositeKeySet {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Conflicting service item lookup",
+			"Set either id, or service_name, application_id and name, but not both.",
+		)
+		return
+	}
+
+	if data.Id.IsNull() && (data.ServiceName.IsNull() || data.ApplicationId.IsNull() || data.Name.IsNull()) {
+		resp.Diagnostics.AddError(
+			"Incomplete service item lookup",
+			"Set either id, or all of service_name, application_id and name to look up a service item.",
+		)
+	}
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *singleServiceItemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data singleServiceItemDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serviceItem netorca.ServiceItem
+	var err error
+
+	if !data.Id.IsNull() {
+		serviceItem, err = c.client.ServiceItemGetById(data.Id.ValueInt64(), data.Pov.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting service item id: %d", data.Id.ValueInt64()), err.Error())
+			return
+		}
+	} else {
+		serviceItem, err = c.lookupServiceItem(data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error looking up service item %s/%d/%s", data.ServiceName.ValueString(), data.ApplicationId.ValueInt64(), data.Name.ValueString()),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found service item id: %d", serviceItem.Id))
+
+	resp.Diagnostics.Append(data.setServiceItem(serviceItem)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// lookupServiceItem finds exactly one service item matching the configured service_name, application_id and name.
+func (c *singleServiceItemDataSource) lookupServiceItem(data singleServiceItemDataSourceData) (netorca.ServiceItem, error) {
+	query, err := netorca.NewServiceItemQuery(map[string]interface{}{
+		"pov":            data.Pov.ValueString(),
+		"service_name":   data.ServiceName.ValueString(),
+		"application_id": data.ApplicationId.ValueInt64(),
+		"name":           data.Name.ValueString(),
+	})
+	if err != nil {
+		return netorca.ServiceItem{}, err
+	}
+
+	// Loose matches may fill the first page, so every page is read before looking for the exact match.
+	serviceItems, err := c.client.ServiceItemsGetAll(query)
+	if err != nil {
+		return netorca.ServiceItem{}, err
+	}
+
+	// The API filters may match loosely, so only exact matches on the composite key are kept.
+	matches := []netorca.ServiceItem{}
+	for _, v := range serviceItems {
+		if v.Name == data.Name.ValueString() &&
+			v.Service.Name == data.ServiceName.ValueString() &&
+			v.Application.Id == data.ApplicationId.ValueInt64() {
+			matches = append(matches, v)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return netorca.ServiceItem{}, fmt.Errorf("no service item matched service_name: %s, application_id: %d, name: %s", data.ServiceName.ValueString(), data.ApplicationId.ValueInt64(), data.Name.ValueString())
+	case 1:
+		return matches[0], nil
+	default:
+		ids := []int64{}
+		for _, v := range matches {
+			ids = append(ids, v.Id)
+		}
+		return netorca.ServiceItem{}, fmt.Errorf("%d service items matched, expected exactly one. Matched ids: %v. Use id to select a single service item", len(matches), ids)
+	}
+}
+
+// setServiceItem populates the data source model from a netorca service item.
+func (d *singleServiceItemDataSourceData) setServiceItem(v netorca.ServiceItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serviceObjVal, serviceDiags := getTerraformServiceItemService(v.Service)
+	diags.Append(serviceDiags...)
+
+	applicationObjVal, applicationDiags, err := getTerraformServiceItemApplication(v.Application)
+	if err != nil {
+		diags.AddError("Error converting service item application", err.Error())
+		return diags
+	}
+	diags.Append(applicationDiags...)
+
+	consumerTeamObjVal, consumerTeamDiags, err := getTerraformServiceItemConsumerTeam(v.ConsumerTeam)
+	if err != nil {
+		diags.AddError("Error converting service item consumer team", err.Error())
+		return diags
+	}
+	diags.Append(consumerTeamDiags...)
+
+	serviceOwnerTeamObjVal, serviceOwnerTeamDiags := getTerraformServiceItemServiceOwnerTeam(v.ServiceOwnerTeam)
+	diags.Append(serviceOwnerTeamDiags...)
+
+	deployedItemData, err := json.Marshal(v.DeployedItem)
+	if err != nil {
+		diags.AddError("Error Marshalling service_item.deployed_item", err.Error())
+		return diags
+	}
+
+	declarationData, err := json.Marshal(v.Declaration)
+	if err != nil {
+		diags.AddError("Error Marshalling service_item.declaration", err.Error())
+		return diags
+	}
+
+	relatedListVal, relatedDiags := getTerraformServiceItemRelated(v.Related)
+	diags.Append(relatedDiags...)
+
+	d.Id = types.Int64Value(v.Id)
+	d.ServiceName = types.StringValue(v.Service.Name)
+	d.ApplicationId = types.Int64Value(v.Application.Id)
+	d.Name = types.StringValue(v.Name)
+	d.Url = types.StringValue(v.Url)
+	d.Created = types.StringValue(v.Created)
+	d.Modified = types.StringValue(v.Modified)
+	d.RuntimeState = types.StringValue(v.RuntimeState)
+	d.ChangeState = types.StringValue(v.ChangeState)
+	d.Service = serviceObjVal
+	d.Application = applicationObjVal
+	d.DeployedItem = types.StringValue(string(deployedItemData))
+	d.ConsumerTeam = consumerTeamObjVal
+	d.ServiceOwnerTeam = serviceOwnerTeamObjVal
+	d.Declaration = types.StringValue(string(declarationData))
+	d.Related = relatedListVal
+	d.HealthcheckStatus = types.Int64PointerValue(v.HealthcheckStatus)
+
+	var valueDiags diag.Diagnostics
+	d.DeclarationValue, valueDiags = tfvalues.DynamicFromStruct(v.Declaration)
+	diags.Append(valueDiags...)
+	d.DeployedItemValue, valueDiags = tfvalues.DynamicFromStruct(v.DeployedItem)
+	diags.Append(valueDiags...)
+	d.ApplicationMetadataValue, valueDiags = tfvalues.DynamicFromStruct(v.Application.Metadata)
+	diags.Append(valueDiags...)
+	d.ConsumerTeamMetadataValue, valueDiags = tfvalues.DynamicFromStruct(v.ConsumerTeam.Metadata)
+	diags.Append(valueDiags...)
+
+	return diags
+}
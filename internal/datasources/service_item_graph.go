@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type serviceItemGraphDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type serviceItemGraphDataSourceData struct {
+	Pov            types.String `tfsdk:"pov"`
+	ServiceItemIds types.List   `tfsdk:"service_item_ids"`
+	Order          types.List   `tfsdk:"order"`
+	Nodes          types.List   `tfsdk:"nodes"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure = &serviceItemGraphDataSource{}
+)
+
+// NewServiceItemGraphDataSource returns a new instance of serviceItemGraphDataSource.
+func NewServiceItemGraphDataSource() datasource.DataSource {
+	return &serviceItemGraphDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *serviceItemGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_item_graph"
+}
+
+// Schema defines the schema for the data source.
+func (c *serviceItemGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return the dependency graph of a set of service items, topologically sorted so each item comes after the items it depends on.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+			},
+			"service_item_ids": schema.ListAttribute{
+				MarkdownDescription: "The service items to build the graph from. Items they depend on are followed through `related` and added to the graph.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+			},
+			"order": schema.ListAttribute{
+				MarkdownDescription: "The ids of every service item in the graph, dependencies first.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"nodes": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"depends_on": schema.ListAttribute{
+							MarkdownDescription: "The ids of the service items this item depends on.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"level": schema.Int64Attribute{
+							MarkdownDescription: "0 for items without dependencies, otherwise one more than the highest level of its dependencies. Items sharing a level don't depend on each other.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *serviceItemGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *serviceItemGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceItemGraphDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []int64
+	resp.Diagnostics.Append(data.ServiceItemIds.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := c.client.ServiceItemGraph(ids, data.Pov.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error building service item dependency graph"), err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Nodes, data.Order, diags = getTerraformServiceItemGraph(nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// getTerraformServiceItemGraph converts the sorted graph nodes into a Terraform list of nodes and a list of ordered ids.
+func getTerraformServiceItemGraph(nodes []netorca.ServiceItemGraphNode) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: serviceItemGraphNodeAttrTypes}
+	elems := []attr.Value{}
+	order := []attr.Value{}
+
+	for _, v := range nodes {
+		dependsOn := []attr.Value{}
+		for _, id := range v.DependsOn {
+			dependsOn = append(dependsOn, types.Int64Value(id))
+		}
+		dependsOnVal, d := types.ListValue(types.Int64Type, dependsOn)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"id":           types.Int64Value(v.Id),
+			"name":         types.StringValue(v.Name),
+			"service_name": types.StringValue(v.ServiceName),
+			"depends_on":   dependsOnVal,
+			"level":        types.Int64Value(v.Level),
+		}
+		objVal, d := types.ObjectValue(serviceItemGraphNodeAttrTypes, obj)
+		diags.Append(d...)
+		elems = append(elems, objVal)
+		order = append(order, types.Int64Value(v.Id))
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	orderVal, d := types.ListValue(types.Int64Type, order)
+	diags.Append(d...)
+
+	return listVal, orderVal, diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var serviceItemGraphNodeAttrTypes = map[string]attr.Type{
+	"id":           types.Int64Type,
+	"name":         types.StringType,
+	"service_name": types.StringType,
+	"depends_on": types.ListType{
+		ElemType: types.Int64Type,
+	},
+	"level": types.Int64Type,
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type serviceItemHistoryDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type serviceItemHistoryDataSourceData struct {
+	Pov           types.String  `tfsdk:"pov"`
+	ServiceItemId types.Int64   `tfsdk:"service_item_id"`
+	Versions      types.List    `tfsdk:"versions"`
+	VersionsValue types.Dynamic `tfsdk:"versions_value"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure = &serviceItemHistoryDataSource{}
+)
+
+// NewServiceItemHistoryDataSource returns a new instance of serviceItemHistoryDataSource.
+func NewServiceItemHistoryDataSource() datasource.DataSource {
+	return &serviceItemHistoryDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *serviceItemHistoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_item_history"
+}
+
+// Schema defines the schema for the data source.
+func (c *serviceItemHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return every version of a service item's declaration across submissions, oldest first, with a diff between consecutive versions.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+			},
+			"service_item_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the service item to return the history of.",
+				Required:            true,
+			},
+			"versions_value": schema.DynamicAttribute{
+				MarkdownDescription: "The same versions as `versions`, with `declaration` and `diff` decoded so they can be read without `jsondecode`.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"versions": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"change_instance_id": schema.Int64Attribute{
+							MarkdownDescription: "The change instance that submitted this version.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "The declaration version recorded by NetOrca, 0 when the change has no new declaration e.g. a DELETE.",
+							Computed:            true,
+						},
+						"submission_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"commit_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"change_type": schema.StringAttribute{
+							MarkdownDescription: "The change type of the change instance e.g. CREATE|MODIFY|DELETE",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The current state of the change instance, e.g. to leave out REJECTED versions.",
+							Computed:            true,
+						},
+						"created": schema.StringAttribute{
+							MarkdownDescription: "When the change instance was created.",
+							Computed:            true,
+						},
+						"declaration": schema.StringAttribute{
+							MarkdownDescription: "The declaration of this version as a json string, `null` when there is none.",
+							Computed:            true,
+						},
+						"diff": schema.StringAttribute{
+							MarkdownDescription: "A JSON Patch (RFC 6902) json string from the previous version's declaration to this one. REJECTED and ERROR versions are skipped as the previous version, since they were never applied. The first version is diffed against an empty declaration.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *serviceItemHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *serviceItemHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceItemHistoryDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	history, err := c.client.ServiceItemHistory(data.ServiceItemId.ValueInt64(), data.Pov.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting history of service item id: %d", data.ServiceItemId.ValueInt64()), err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Versions, diags = getTerraformServiceItemHistory(history)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.VersionsValue, diags = getTerraformServiceItemHistoryValue(history)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// getTerraformServiceItemHistory converts the declaration versions into a Terraform list of objects.
+func getTerraformServiceItemHistory(history []netorca.ServiceItemHistoryVersion) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: serviceItemHistoryVersionAttrTypes}
+	elems := []attr.Value{}
+
+	for _, v := range history {
+		declaration, err := json.Marshal(v.Declaration)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error marshalling declaration from change instance id: %d", v.ChangeInstanceId), err.Error())
+			return types.ListNull(elemType), diags
+		}
+		diff, err := json.Marshal(v.Diff)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error marshalling declaration diff from change instance id: %d", v.ChangeInstanceId), err.Error())
+			return types.ListNull(elemType), diags
+		}
+
+		obj := map[string]attr.Value{
+			"change_instance_id": types.Int64Value(v.ChangeInstanceId),
+			"version":            types.Int64Value(v.Version),
+			"submission_id":      types.Int64Value(v.SubmissionId),
+			"commit_id":          types.StringValue(v.CommitId),
+			"change_type":        types.StringValue(v.ChangeType),
+			"state":              types.StringValue(v.State),
+			"created":            types.StringValue(v.Created),
+			"declaration":        types.StringValue(string(declaration)),
+			"diff":               types.StringValue(string(diff)),
+		}
+		objVal, d := types.ObjectValue(serviceItemHistoryVersionAttrTypes, obj)
+		diags.Append(d...)
+		elems = append(elems, objVal)
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+// getTerraformServiceItemHistoryValue converts the declaration versions into a dynamic value with decoded declarations and diffs.
+func getTerraformServiceItemHistoryValue(history []netorca.ServiceItemHistoryVersion) (types.Dynamic, diag.Diagnostics) {
+	versions := []map[string]interface{}{}
+
+	for _, v := range history {
+		versions = append(versions, map[string]interface{}{
+			"change_instance_id": v.ChangeInstanceId,
+			"version":            v.Version,
+			"submission_id":      v.SubmissionId,
+			"commit_id":          v.CommitId,
+			"change_type":        v.ChangeType,
+			"state":              v.State,
+			"created":            v.Created,
+			"declaration":        v.Declaration,
+			"diff":               v.Diff,
+		})
+	}
+
+	return tfvalues.DynamicFromStruct(versions)
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var serviceItemHistoryVersionAttrTypes = map[string]attr.Type{
+	"change_instance_id": types.Int64Type,
+	"version":            types.Int64Type,
+	"submission_id":      types.Int64Type,
+	"commit_id":          types.StringType,
+	"change_type":        types.StringType,
+	"state":              types.StringType,
+	"created":            types.StringType,
+	"declaration":        types.StringType,
+	"diff":               types.StringType,
+}
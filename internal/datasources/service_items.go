@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+	"terraform-provider-netorca/internal/where"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,10 +28,12 @@ type serviceItemDataSource struct {
 }
 
 type serviceItemDataSourceData struct {
-	ServiceItemCount types.Int64  `tfsdk:"service_item_count"`
-	Pov              types.String `tfsdk:"pov"`
-	ServiceItems     types.List   `tfsdk:"service_items"`
-	Filters          types.Object `tfsdk:"filters"`
+	ServiceItemCount  types.Int64   `tfsdk:"service_item_count"`
+	Pov               types.String  `tfsdk:"pov"`
+	ServiceItems      types.List    `tfsdk:"service_items"`
+	ServiceItemsValue types.Dynamic `tfsdk:"service_items_value"`
+	Filters           types.Object  `tfsdk:"filters"`
+	Where             types.List    `tfsdk:"where"`
 
 	// internal field for parsed filter values.
 	filters *serviceItemDataSourceFiltersData `tfsdk:"-"`
@@ -81,11 +85,16 @@ func (e *serviceItemDataSource) Schema(ctx context.Context, req datasource.Schem
 				Required:            true,
 			},
 			"service_item_count": schema.Int64Attribute{
-				MarkdownDescription: "The number of change instances returned as a part of this query",
+				MarkdownDescription: "The number of service items returned as a part of this query, or the number left after applying `where`.",
+				Computed:            true,
+			},
+			"service_items_value": schema.DynamicAttribute{
+				MarkdownDescription: "The returned service items with `declaration`, `deployed_item` and metadata decoded into objects, e.g. `service_items_value[0].declaration.zone`.",
 				Computed:            true,
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"where": whereBlock("declaration.zone"),
 			"filters": schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
 					"application_id": schema.Int64Attribute{
@@ -135,6 +144,8 @@ func (e *serviceItemDataSource) Schema(ctx context.Context, req datasource.Schem
 				},
 			},
 			"service_items": schema.ListNestedBlock{
+				MarkdownDescription: "The returned service items. Each item is at the same index in `service_items_value`, where its json attributes are decoded, " +
+					"e.g. `service_items_value[0].declaration.zone` for `service_items[0].declaration`.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int64Attribute{
@@ -167,7 +178,8 @@ func (e *serviceItemDataSource) Schema(ctx context.Context, req datasource.Schem
 							AttributeTypes: serviceItemApplicationAttrType,
 						},
 						"deployed_item": schema.StringAttribute{
-							Computed: true,
+							MarkdownDescription: "The deployed_item as a json string, decoded in `service_items_value[n].deployed_item`.",
+							Computed:            true,
 						},
 						"consumer_team": schema.ObjectAttribute{
 							Computed:       true,
@@ -178,11 +190,17 @@ func (e *serviceItemDataSource) Schema(ctx context.Context, req datasource.Schem
 							AttributeTypes: serviceItemServiceOwnerTeamAttrTypes,
 						},
 						"declaration": schema.StringAttribute{
-							Computed: true,
+							MarkdownDescription: "The declaration as a json string, decoded in `service_items_value[n].declaration`.",
+							Computed:            true,
 						},
 						"healthcheck_status": schema.Int64Attribute{
 							Computed: true,
 						},
+						"related": schema.ListAttribute{
+							MarkdownDescription: "The service items this service item depends on.",
+							Computed:            true,
+							ElementType:         types.ObjectType{AttrTypes: serviceItemRelatedAttrTypes},
+						},
 					},
 				},
 			},
@@ -221,6 +239,12 @@ func (c *serviceItemDataSource) Read(ctx context.Context, req datasource.ReadReq
 		resp.Diagnostics.Append(diags...)
 	}
 
+	conditions, diags := getWhereConditions(ctx, data.Where)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set values for query parameters.
 	serviceItemQuery := make(map[string]interface{})
 	serviceItemQuery["pov"] = data.Pov.ValueString()
@@ -244,18 +268,41 @@ func (c *serviceItemDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	serviceItems, err := c.client.ServiceItemsGet(query)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintln("Error getting service items"), err.Error())
-		return
+	var serviceItems []netorca.ServiceItem
+	if len(conditions) > 0 {
+		// The conditions are evaluated by the provider, so every page is read for them to see every match.
+		serviceItems, err = c.client.ServiceItemsGetAll(query)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintln("Error getting service items"), err.Error())
+			return
+		}
+
+		serviceItems, err = where.Filter(serviceItems, conditions)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintln("Error evaluating where conditions on service items"), err.Error())
+			return
+		}
+		data.ServiceItemCount = types.Int64Value(int64(len(serviceItems)))
+	} else {
+		page, err := c.client.ServiceItemsGet(query)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintln("Error getting service items"), err.Error())
+			return
+		}
+
+		serviceItems = page.Results
+		data.ServiceItemCount = types.Int64Value(int64(page.Count))
 	}
 
-	data.ServiceItemCount = types.Int64Value(int64(serviceItems.Count))
-	data.ServiceItems, err = getTerraformServiceItems(serviceItems.Results, resp)
+	data.ServiceItems, err = getTerraformServiceItems(serviceItems, resp)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintln("Error serialising netorca service items into terraform objects"), err.Error())
 	}
 
+	serviceItemsValue, diags := tfvalues.DynamicFromStruct(serviceItems)
+	resp.Diagnostics.Append(diags...)
+	data.ServiceItemsValue = serviceItemsValue
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -278,94 +325,164 @@ func getTerraformServiceItems(serviceItems []netorca.ServiceItem, resp *datasour
 	elems := []attr.Value{}
 
 	for _, v := range serviceItems {
-		applicationMetadata, err := json.Marshal(v.Application.Metadata)
+		serviceItemObjVal, serviceItemDiags, err := getTerraformServiceItem(v)
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling service_item.application.metadata"), err.Error())
+			resp.Diagnostics.AddError(fmt.Sprintf("Error converting service item id: %d", v.Id), err.Error())
 			return types.ListNull(elemType), err
 		}
+		diags.Append(serviceItemDiags...)
+		elems = append(elems, serviceItemObjVal)
+	}
 
-		netorcaApplicationObj := map[string]attr.Value{
-			"id":       types.Int64Value(int64(v.Application.Id)),
-			"name":     types.StringValue(v.Application.Name),
-			"metadata": types.StringValue(string(applicationMetadata)),
-			"owner":    types.Int64Value(int64(v.Application.Owner)),
-		}
-		netorcaApplicationObjVal, netorcaApplicationOwnerDiags := types.ObjectValue(serviceItemApplicationAttrType, netorcaApplicationObj)
-		diags.Append(netorcaApplicationOwnerDiags...)
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	resp.Diagnostics.Append(diags...)
+	return listVal, nil
+}
 
-		serviceItemOwnerObj := map[string]attr.Value{
-			"id":   types.Int64Value(int64(v.Service.Owner.Id)),
-			"name": types.StringValue(v.Service.Owner.Name),
-		}
-		serviceItemOwnerObjVal, serviceItemOwnerDiags := types.ObjectValue(serviceItemOwnerAttrType, serviceItemOwnerObj)
-		diags.Append(serviceItemOwnerDiags...)
-
-		serviceItemServiceObj := map[string]attr.Value{
-			"id":          types.Int64Value(int64(v.Service.Id)),
-			"name":        types.StringValue(v.Service.Name),
-			"owner":       types.Object(serviceItemOwnerObjVal),
-			"healthcheck": types.BoolValue(v.Service.HealthCheck),
-		}
-		serviceItemServiceObjVal, serviceItemServiceDiags := types.ObjectValue(serviceItemServiceAttrType, serviceItemServiceObj)
-		diags.Append(serviceItemServiceDiags...)
+// getTerraformServiceItem converts a single netorca service item into a Terraform object.
+func getTerraformServiceItem(v netorca.ServiceItem) (types.Object, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
 
-		deployedItemData, err := json.Marshal(v.DeployedItem)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling service_item.deployed_item.data"), err.Error())
-			return types.ListNull(elemType), err
-		}
+	applicationObjVal, d, err := getTerraformServiceItemApplication(v.Application)
+	if err != nil {
+		return types.ObjectNull(serviceItemAttrTypes), diags, err
+	}
+	diags.Append(d...)
 
-		consumerTeamMetadata, err := json.Marshal(v.ConsumerTeam.Metadata)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling service_item.consumer_team.metadata"), err.Error())
-			return types.ListNull(elemType), err
-		}
-		serviceItemConsumerTeamObj := map[string]attr.Value{
-			"id":       types.Int64Value(int64(v.ConsumerTeam.Id)),
-			"name":     types.StringValue(v.ConsumerTeam.Name),
-			"metadata": types.StringValue(string(consumerTeamMetadata)),
-		}
-		serviceItemConsumerTeamObjVal, serviceItemConsumerTeamDiags := types.ObjectValue(serviceItemConsumerTeamAttrTypes, serviceItemConsumerTeamObj)
-		diags.Append(serviceItemConsumerTeamDiags...)
+	serviceObjVal, d := getTerraformServiceItemService(v.Service)
+	diags.Append(d...)
 
-		serviceItemServiceOwnerTeamObj := map[string]attr.Value{
-			"id":   types.Int64Value(int64(v.ServiceOwnerTeam.Id)),
-			"name": types.StringValue(v.ServiceOwnerTeam.Name),
-		}
-		serviceItemServiceOwnerTeamObjVal, serviceItemServiceOwnerTeamDiags := types.ObjectValue(serviceItemServiceOwnerTeamAttrTypes, serviceItemServiceOwnerTeamObj)
-		diags.Append(serviceItemServiceOwnerTeamDiags...)
+	consumerTeamObjVal, d, err := getTerraformServiceItemConsumerTeam(v.ConsumerTeam)
+	if err != nil {
+		return types.ObjectNull(serviceItemAttrTypes), diags, err
+	}
+	diags.Append(d...)
 
-		declarationValues, err := json.Marshal(v.Declaration)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintln("Error Marshalling service_item.declaration"), err.Error())
-			return types.ListNull(elemType), err
-		}
+	serviceOwnerTeamObjVal, d := getTerraformServiceItemServiceOwnerTeam(v.ServiceOwnerTeam)
+	diags.Append(d...)
+
+	relatedListVal, d := getTerraformServiceItemRelated(v.Related)
+	diags.Append(d...)
+
+	deployedItemData, err := json.Marshal(v.DeployedItem)
+	if err != nil {
+		return types.ObjectNull(serviceItemAttrTypes), diags, fmt.Errorf("error marshalling service_item.deployed_item: %w", err)
+	}
+
+	declarationValues, err := json.Marshal(v.Declaration)
+	if err != nil {
+		return types.ObjectNull(serviceItemAttrTypes), diags, fmt.Errorf("error marshalling service_item.declaration: %w", err)
+	}
+
+	obj := map[string]attr.Value{
+		"id":                 types.Int64Value(v.Id),
+		"url":                types.StringValue(v.Url),
+		"name":               types.StringValue(v.Name),
+		"created":            types.StringValue(v.Created),
+		"modified":           types.StringValue(v.Modified),
+		"runtime_state":      types.StringValue(v.RuntimeState),
+		"change_state":       types.StringValue(v.ChangeState),
+		"service":            serviceObjVal,
+		"application":        applicationObjVal,
+		"deployed_item":      types.StringValue(string(deployedItemData)),
+		"consumer_team":      consumerTeamObjVal,
+		"service_owner_team": serviceOwnerTeamObjVal,
+		"declaration":        types.StringValue(string(declarationValues)),
+		"healthcheck_status": types.Int64PointerValue(v.HealthcheckStatus),
+		"related":            relatedListVal,
+	}
+	objVal, d := types.ObjectValue(serviceItemAttrTypes, obj)
+	diags.Append(d...)
+
+	return objVal, diags, nil
+}
+
+// getTerraformServiceItemApplication converts a netorca application into a Terraform object.
+func getTerraformServiceItemApplication(a netorca.NetOrcaApplication) (types.Object, diag.Diagnostics, error) {
+	metadata, err := json.Marshal(a.Metadata)
+	if err != nil {
+		return types.ObjectNull(serviceItemApplicationAttrType), nil, fmt.Errorf("error marshalling service_item.application.metadata: %w", err)
+	}
+
+	obj := map[string]attr.Value{
+		"id":       types.Int64Value(a.Id),
+		"name":     types.StringValue(a.Name),
+		"metadata": types.StringValue(string(metadata)),
+		"owner":    types.Int64Value(a.Owner),
+	}
+	objVal, diags := types.ObjectValue(serviceItemApplicationAttrType, obj)
+	return objVal, diags, nil
+}
+
+// getTerraformServiceItemService converts a netorca service item service into a Terraform object.
+func getTerraformServiceItemService(s netorca.ServiceItemService) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
+	ownerObj := map[string]attr.Value{
+		"id":   types.Int64Value(s.Owner.Id),
+		"name": types.StringValue(s.Owner.Name),
+	}
+	ownerObjVal, d := types.ObjectValue(serviceItemOwnerAttrType, ownerObj)
+	diags.Append(d...)
+
+	obj := map[string]attr.Value{
+		"id":          types.Int64Value(s.Id),
+		"name":        types.StringValue(s.Name),
+		"owner":       ownerObjVal,
+		"healthcheck": types.BoolValue(s.HealthCheck),
+	}
+	objVal, d := types.ObjectValue(serviceItemServiceAttrType, obj)
+	diags.Append(d...)
+
+	return objVal, diags
+}
+
+// getTerraformServiceItemConsumerTeam converts a netorca service item consumer team into a Terraform object.
+func getTerraformServiceItemConsumerTeam(t netorca.ServiceItemConsumerTeam) (types.Object, diag.Diagnostics, error) {
+	metadata, err := json.Marshal(t.Metadata)
+	if err != nil {
+		return types.ObjectNull(serviceItemConsumerTeamAttrTypes), nil, fmt.Errorf("error marshalling service_item.consumer_team.metadata: %w", err)
+	}
+
+	obj := map[string]attr.Value{
+		"id":       types.Int64Value(t.Id),
+		"name":     types.StringValue(t.Name),
+		"metadata": types.StringValue(string(metadata)),
+	}
+	objVal, diags := types.ObjectValue(serviceItemConsumerTeamAttrTypes, obj)
+	return objVal, diags, nil
+}
+
+// getTerraformServiceItemServiceOwnerTeam converts a netorca service item service owner team into a Terraform object.
+func getTerraformServiceItemServiceOwnerTeam(t netorca.ServiceItemServiceOwnerTeam) (types.Object, diag.Diagnostics) {
+	obj := map[string]attr.Value{
+		"id":   types.Int64Value(t.Id),
+		"name": types.StringValue(t.Name),
+	}
+	return types.ObjectValue(serviceItemServiceOwnerTeamAttrTypes, obj)
+}
+
+// getTerraformServiceItemRelated converts the related items of a netorca service item into a Terraform list.
+func getTerraformServiceItemRelated(related []netorca.ServiceItemRelated) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: serviceItemRelatedAttrTypes}
+	elems := []attr.Value{}
+
+	for _, r := range related {
 		obj := map[string]attr.Value{
-			"id":                 types.Int64Value(v.Id),
-			"url":                types.StringValue(v.Url),
-			"name":               types.StringValue(v.Name),
-			"created":            types.StringValue(v.Created),
-			"modified":           types.StringValue(v.Modified),
-			"runtime_state":      types.StringValue(v.RuntimeState),
-			"change_state":       types.StringValue(v.ChangeState),
-			"service":            types.Object(serviceItemServiceObjVal),
-			"application":        types.Object(netorcaApplicationObjVal),
-			"deployed_item":      types.StringValue(string(deployedItemData)),
-			"consumer_team":      types.Object(serviceItemConsumerTeamObjVal),
-			"service_owner_team": types.Object(serviceItemServiceOwnerTeamObjVal),
-			"declaration":        types.StringValue(string(declarationValues)),
-			"healthcheck_status": types.Int64PointerValue(v.HealthcheckStatus),
+			"id":           types.Int64Value(r.Id),
+			"name":         types.StringValue(r.Name),
+			"service_name": types.StringValue(r.ServiceName),
 		}
-		objVal, d := types.ObjectValue(serviceItemAttrTypes, obj)
+		objVal, d := types.ObjectValue(serviceItemRelatedAttrTypes, obj)
 		diags.Append(d...)
 		elems = append(elems, objVal)
 	}
 
 	listVal, d := types.ListValue(elemType, elems)
 	diags.Append(d...)
-	resp.Diagnostics.Append(d...)
-	return listVal, nil
+	return listVal, diags
 }
 
 // -----------------------------------------------------------------------------
@@ -434,6 +551,9 @@ var serviceItemAttrTypes = map[string]attr.Type{
 	},
 	"declaration":        types.StringType,
 	"healthcheck_status": types.Int64Type,
+	"related": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: serviceItemRelatedAttrTypes},
+	},
 }
 
 var serviceItemConsumerTeamAttrTypes = map[string]attr.Type{
@@ -446,3 +566,9 @@ var serviceItemServiceOwnerTeamAttrTypes = map[string]attr.Type{
 	"id":   types.Int64Type,
 	"name": types.StringType,
 }
+
+var serviceItemRelatedAttrTypes = map[string]attr.Type{
+	"id":           types.Int64Type,
+	"name":         types.StringType,
+	"service_name": types.StringType,
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-netorca/internal/netorca"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type submissionValidationDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type submissionValidationDataSourceData struct {
+	Declaration     types.String `tfsdk:"declaration"`
+	Partial         types.Bool   `tfsdk:"partial"`
+	Valid           types.Bool   `tfsdk:"valid"`
+	Errors          types.List   `tfsdk:"errors"`
+	ChangeInstances types.List   `tfsdk:"change_instances"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure      = &submissionValidationDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &submissionValidationDataSource{}
+)
+
+// NewSubmissionValidationDataSource returns a new instance of submissionValidationDataSource.
+func NewSubmissionValidationDataSource() datasource.DataSource {
+	return &submissionValidationDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *submissionValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submission_validation"
+}
+
+// Schema defines the schema for the data source.
+func (c *submissionValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to check whether NetOrca would accept a consumer declaration, without submitting it. " +
+			"An invalid declaration doesn't fail the read, it is reported in valid and errors.",
+		Attributes: map[string]schema.Attribute{
+			"declaration": schema.StringAttribute{
+				MarkdownDescription: "A json object declaring applications keyed by name, in the same form as the `netorca_submission` declaration.",
+				Required:            true,
+			},
+			"partial": schema.BoolAttribute{
+				MarkdownDescription: "Validate the declaration as a partial submission, which only changes the applications it contains. Defaults to true, like `netorca_submission`.",
+				Optional:            true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether NetOrca would accept the declaration.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"errors": schema.ListNestedBlock{
+				MarkdownDescription: "The validation errors, empty when the declaration is valid.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path of the invalid value in the declaration, e.g. `app7.services.a_record.0.zone`.",
+							Computed:            true,
+						},
+						"application_name": schema.StringAttribute{
+							MarkdownDescription: "The application the error is about, empty for errors about the declaration as a whole.",
+							Computed:            true,
+						},
+						"service_name": schema.StringAttribute{
+							MarkdownDescription: "The service the error is about, empty for errors about an application as a whole.",
+							Computed:            true,
+						},
+						"service_item_name": schema.StringAttribute{
+							MarkdownDescription: "The service item the error is about, empty for errors about a service as a whole.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"change_instances": schema.ListNestedBlock{
+				MarkdownDescription: "The change instances submitting the declaration would create.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"application_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_item_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"change_type": schema.StringAttribute{
+							MarkdownDescription: "The type of change (CREATE|MODIFY|DELETE).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *submissionValidationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// ValidateConfig ensures declaration has the shape of a submission.
+func (c *submissionValidationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data submissionValidationDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Declaration.IsNull() || data.Declaration.IsUnknown() {
+		return
+	}
+
+	if _, err := netorca.ParseSubmission(data.Declaration.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("declaration"), "Invalid declaration", err.Error())
+	}
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *submissionValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data submissionValidationDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	submission, err := netorca.ParseSubmission(data.Declaration.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("declaration"), "Invalid declaration", err.Error())
+		return
+	}
+
+	partial := data.Partial.IsNull() || data.Partial.ValueBool()
+
+	validation, err := c.client.SubmissionValidate(submission, partial)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error validating declaration"), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.setValidation(submission, validation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// setValidation populates the data source model from a submission validation.
+func (d *submissionValidationDataSourceData) setValidation(submission netorca.Submission, validation netorca.SubmissionValidation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	errorElems := []attr.Value{}
+	for _, v := range validation.Errors {
+		applicationName, serviceName, name := submission.ErrorServiceItem(v)
+		obj, objDiags := types.ObjectValue(submissionValidationErrorAttrTypes, map[string]attr.Value{
+			"path":              types.StringValue(strings.Join(v.Path, ".")),
+			"application_name":  types.StringValue(applicationName),
+			"service_name":      types.StringValue(serviceName),
+			"service_item_name": types.StringValue(name),
+			"message":           types.StringValue(v.Message),
+		})
+		diags.Append(objDiags...)
+		errorElems = append(errorElems, obj)
+	}
+
+	changeInstanceElems := []attr.Value{}
+	for _, v := range validation.ChangeInstances {
+		obj, objDiags := types.ObjectValue(submissionValidationChangeInstanceAttrTypes, map[string]attr.Value{
+			"application_name":  types.StringValue(v.ApplicationName),
+			"service_name":      types.StringValue(v.ServiceName),
+			"service_item_name": types.StringValue(v.ServiceItemName),
+			"change_type":       types.StringValue(v.ChangeType),
+		})
+		diags.Append(objDiags...)
+		changeInstanceElems = append(changeInstanceElems, obj)
+	}
+
+	errorList, listDiags := types.ListValue(types.ObjectType{AttrTypes: submissionValidationErrorAttrTypes}, errorElems)
+	diags.Append(listDiags...)
+
+	changeInstanceList, listDiags := types.ListValue(types.ObjectType{AttrTypes: submissionValidationChangeInstanceAttrTypes}, changeInstanceElems)
+	diags.Append(listDiags...)
+
+	d.Valid = types.BoolValue(validation.Valid)
+	d.Errors = errorList
+	d.ChangeInstances = changeInstanceList
+
+	return diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var submissionValidationErrorAttrTypes = map[string]attr.Type{
+	"path":              types.StringType,
+	"application_name":  types.StringType,
+	"service_name":      types.StringType,
+	"service_item_name": types.StringType,
+	"message":           types.StringType,
+}
+
+var submissionValidationChangeInstanceAttrTypes = map[string]attr.Type{
+	"application_name":  types.StringType,
+	"service_name":      types.StringType,
+	"service_item_name": types.StringType,
+	"change_type":       types.StringType,
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-netorca/internal/netorca"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type submissionsDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type submissionsDataSourceData struct {
+	ApplicationId   types.Int64  `tfsdk:"application_id"`
+	ConsumerTeamId  types.Int64  `tfsdk:"consumer_team_id"`
+	CommitId        types.String `tfsdk:"commit_id"`
+	CreatedAfter    types.String `tfsdk:"created_after"`
+	CreatedBefore   types.String `tfsdk:"created_before"`
+	SubmissionCount types.Int64  `tfsdk:"submission_count"`
+	Submissions     types.List   `tfsdk:"submissions"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure      = &submissionsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &submissionsDataSource{}
+)
+
+// NewSubmissionsDataSource returns a new instance of submissionsDataSource.
+func NewSubmissionsDataSource() datasource.DataSource {
+	return &submissionsDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *submissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submissions"
+}
+
+// Schema defines the schema for the data source.
+func (c *submissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return the consumer submissions made to NetOrca, with the change instances each of them created.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.Int64Attribute{
+				MarkdownDescription: "Returns only submissions of the application with this id.",
+				Optional:            true,
+			},
+			"consumer_team_id": schema.Int64Attribute{
+				MarkdownDescription: "Returns only submissions made by this consumer team.",
+				Optional:            true,
+			},
+			"commit_id": schema.StringAttribute{
+				MarkdownDescription: "Returns only submissions recorded against this commit id.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Returns only submissions made after this RFC 3339 timestamp, e.g. `2026-10-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Returns only submissions made before this RFC 3339 timestamp, e.g. `2026-10-31T23:59:59Z`.",
+				Optional:            true,
+			},
+			"submission_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of submissions returned.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"submissions": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"commit_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status NetOrca recorded for the submission.",
+							Computed:            true,
+						},
+						"created": schema.StringAttribute{
+							Computed: true,
+						},
+						"consumer_team_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"consumer_team_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"change_instance_ids": schema.ListAttribute{
+							MarkdownDescription: "The IDs of the change instances the submission created, empty when it didn't change any service item.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *submissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// ValidateConfig ensures the date range bounds are RFC 3339 timestamps.
+func (c *submissionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data submissionsDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bounds := []struct {
+		name  string
+		value types.String
+	}{
+		{"created_after", data.CreatedAfter},
+		{"created_before", data.CreatedBefore},
+	}
+
+	for _, v := range bounds {
+		if v.value.IsNull() || v.value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, v.value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(v.name),
+				"Invalid timestamp",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp e.g. 2026-10-01T00:00:00Z: %s", v.name, err.Error()),
+			)
+		}
+	}
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *submissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data submissionsDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := netorca.SubmissionQuery{
+		Pov:            netorca.PovConsumer,
+		ApplicationId:  data.ApplicationId.ValueInt64(),
+		ConsumerTeamId: data.ConsumerTeamId.ValueInt64(),
+		CommitId:       data.CommitId.ValueString(),
+		CreatedAfter:   data.CreatedAfter.ValueString(),
+		CreatedBefore:  data.CreatedBefore.ValueString(),
+	}
+
+	submissions, err := c.client.SubmissionsGet(&query)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error getting submissions"), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d submissions", len(submissions)))
+
+	changeInstancesBySubmission, err := c.submissionChangeInstances(query, submissions)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error getting the change instances of submissions"), err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	elems := []attr.Value{}
+
+	for _, v := range submissions {
+		obj, d := getTerraformSubmission(v, changeInstancesBySubmission[v.Id])
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: submissionAttrTypes}, elems)
+	diags.Append(d...)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SubmissionCount = types.Int64Value(int64(len(submissions)))
+	data.Submissions = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// submissionChangeInstances returns the change instances created by each of the submissions, keyed by submission id.
+// When the query is scoped by application, consumer team or commit id, the change instances are read in one query scoped
+// the same way and grouped, leaving out those of other submissions. Change instances can't be filtered by creation
+// time, so otherwise they are read per submission rather than reading every change instance the consumer can see.
+func (c *submissionsDataSource) submissionChangeInstances(query netorca.SubmissionQuery, submissions []netorca.SubmissionRecord) (map[int64][]netorca.ChangeInstance, error) {
+	changeInstancesBySubmission := map[int64][]netorca.ChangeInstance{}
+	if len(submissions) == 0 {
+		return changeInstancesBySubmission, nil
+	}
+
+	if query.ApplicationId == 0 && query.ConsumerTeamId == 0 && query.CommitId == "" {
+		for _, v := range submissions {
+			changeInstances, err := c.client.ChangeInstanceGetAll(&netorca.ChangeInstanceQuery{Pov: netorca.PovConsumer, SubmissionId: v.Id})
+			if err != nil {
+				return nil, err
+			}
+			changeInstancesBySubmission[v.Id] = changeInstances
+		}
+		return changeInstancesBySubmission, nil
+	}
+
+	changeInstances, err := c.client.ChangeInstanceGetAll(&netorca.ChangeInstanceQuery{
+		Pov:            netorca.PovConsumer,
+		ApplicationId:  query.ApplicationId,
+		ConsumerTeamId: query.ConsumerTeamId,
+		CommitId:       query.CommitId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range changeInstances {
+		changeInstancesBySubmission[v.Submission.Id] = append(changeInstancesBySubmission[v.Submission.Id], v)
+	}
+
+	return changeInstancesBySubmission, nil
+}
+
+// getTerraformSubmission converts a submission and the change instances it created into a Terraform object.
+func getTerraformSubmission(v netorca.SubmissionRecord, changeInstances []netorca.ChangeInstance) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ids := make([]attr.Value, 0, len(changeInstances))
+	for _, ci := range changeInstances {
+		ids = append(ids, types.Int64Value(ci.Id))
+	}
+
+	idList, d := types.ListValue(types.Int64Type, ids)
+	diags.Append(d...)
+
+	obj, d := types.ObjectValue(submissionAttrTypes, map[string]attr.Value{
+		"id":                  types.Int64Value(v.Id),
+		"commit_id":           types.StringValue(v.CommitId),
+		"status":              types.StringValue(v.Status),
+		"created":             types.StringValue(v.Created),
+		"consumer_team_id":    types.Int64Value(v.ConsumerTeam.Id),
+		"consumer_team_name":  types.StringValue(v.ConsumerTeam.Name),
+		"change_instance_ids": idList,
+	})
+	diags.Append(d...)
+
+	return obj, diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var submissionAttrTypes = map[string]attr.Type{
+	"id":                  types.Int64Type,
+	"commit_id":           types.StringType,
+	"status":              types.StringType,
+	"created":             types.StringType,
+	"consumer_team_id":    types.Int64Type,
+	"consumer_team_name":  types.StringType,
+	"change_instance_ids": types.ListType{ElemType: types.Int64Type},
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type singleTeamDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type singleTeamDataSourceData struct {
+	Pov      types.String `tfsdk:"pov"`
+	TeamType types.String `tfsdk:"team_type"`
+	Id       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Metadata types.String `tfsdk:"metadata"`
+
+	MetadataValue types.Dynamic `tfsdk:"metadata_value"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure      = &singleTeamDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &singleTeamDataSource{}
+)
+
+// NewSingleTeamDataSource returns a new instance of singleTeamDataSource.
+func NewSingleTeamDataSource() datasource.DataSource {
+	return &singleTeamDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *singleTeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the data source.
+func (c *singleTeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return a single consumer or service owner team, looked up either by id or by name, with its metadata decoded.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+			},
+			"team_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the team (consumer|serviceowner).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.TeamTypes...),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The id of the team. Conflicts with `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the team. Conflicts with `id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"metadata": schema.StringAttribute{
+				MarkdownDescription: "The metadata of the team as a JSON string.",
+				Computed:            true,
+			},
+			"metadata_value": schema.DynamicAttribute{
+				MarkdownDescription: "The `metadata` decoded into an object, e.g. `metadata_value.cost_centre`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *singleTeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// ValidateConfig ensures the team is looked up either by id or by name, but not both.
+func (c *singleTeamDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data singleTeamDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation until all lookup values are known.
+	if data.Id.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if !data.Id.IsNull() && !data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Conflicting team lookup",
+			"Set either id or name, but not both.",
+		)
+		return
+	}
+
+	if data.Id.IsNull() && data.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Incomplete team lookup",
+			"Set either id or name to look up a team.",
+		)
+	}
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *singleTeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data singleTeamDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var team netorca.Team
+	var err error
+
+	if !data.Id.IsNull() {
+		team, err = c.client.TeamGetById(data.Id.ValueInt64(), data.TeamType.ValueString(), data.Pov.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting %s team id: %d", data.TeamType.ValueString(), data.Id.ValueInt64()), err.Error())
+			return
+		}
+	} else {
+		team, err = c.lookupTeam(data)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error looking up %s team %s", data.TeamType.ValueString(), data.Name.ValueString()), err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found team id: %d", team.Id))
+
+	resp.Diagnostics.Append(data.setTeam(team)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// lookupTeam finds exactly one team of the configured type with the configured name.
+func (c *singleTeamDataSource) lookupTeam(data singleTeamDataSourceData) (netorca.Team, error) {
+	teams, err := c.client.TeamsGet(&netorca.TeamQuery{
+		Pov:      data.Pov.ValueString(),
+		TeamType: data.TeamType.ValueString(),
+		Name:     data.Name.ValueString(),
+	})
+	if err != nil {
+		return netorca.Team{}, err
+	}
+
+	// The API filters may match loosely, so only exact name matches are kept.
+	matches := []netorca.Team{}
+	for _, v := range teams {
+		if v.Name == data.Name.ValueString() {
+			matches = append(matches, v)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return netorca.Team{}, fmt.Errorf("no %s team matched name: %s", data.TeamType.ValueString(), data.Name.ValueString())
+	case 1:
+		return matches[0], nil
+	default:
+		ids := []int64{}
+		for _, v := range matches {
+			ids = append(ids, v.Id)
+		}
+		return netorca.Team{}, fmt.Errorf("%d teams matched, expected exactly one. Matched ids: %v. Use id to select a single team", len(matches), ids)
+	}
+}
+
+// setTeam populates the data source model from a netorca team.
+func (d *singleTeamDataSourceData) setTeam(v netorca.Team) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metadata := v.MetadataData()
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling metadata from team id: %d", v.Id), err.Error())
+		return diags
+	}
+
+	metadataValue, valueDiags := tfvalues.DynamicFromStruct(metadata)
+	diags.Append(valueDiags...)
+
+	d.Id = types.Int64Value(v.Id)
+	d.Name = types.StringValue(v.Name)
+	d.Metadata = types.StringValue(string(b))
+	d.MetadataValue = metadataValue
+
+	return diags
+}
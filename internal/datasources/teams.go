@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type teamDataSource struct {
+	client *netorca.NetOrcaClient
+}
+
+type teamDataSourceData struct {
+	Pov      types.String `tfsdk:"pov"`
+	TeamType types.String `tfsdk:"team_type"`
+	Name     types.String `tfsdk:"name"`
+	Teams    types.List   `tfsdk:"teams"`
+
+	TeamsValue types.Dynamic `tfsdk:"teams_value"`
+}
+
+// -----------------------------------------------------------------------------
+// Interface Assertions - Ensure the data source implements the necessary interfaces for Terraform
+// -----------------------------------------------------------------------------
+
+var (
+	_ datasource.DataSourceWithConfigure = &teamDataSource{}
+)
+
+// NewTeamDataSource returns a new instance of teamDataSource.
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+// -----------------------------------------------------------------------------
+// DataSourceWithConfigure Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the data source type name.
+func (c *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+// Schema defines the schema for the data source.
+func (c *teamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data provider to return the consumer and service owner teams visible from a POV, with their metadata decoded.",
+		Attributes: map[string]schema.Attribute{
+			"pov": schema.StringAttribute{
+				MarkdownDescription: "The POV from which to make the request (serviceowner|consumer)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+			},
+			"team_type": schema.StringAttribute{
+				MarkdownDescription: "Returns only teams of this type (consumer|serviceowner). Teams of every type are returned when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.TeamTypes...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Returns only teams with this name.",
+				Optional:            true,
+			},
+			"teams_value": schema.DynamicAttribute{
+				MarkdownDescription: "The returned teams with their metadata decoded into objects, e.g. `teams_value[0].metadata.cost_centre`.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"teams": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"team_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the team (consumer|serviceowner).",
+							Computed:            true,
+						},
+						"metadata": schema.StringAttribute{
+							MarkdownDescription: "The metadata of the team as a JSON string.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (c *teamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	c.client = client
+}
+
+// Read is called when Terraform needs to read the state of the data source.
+func (c *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data teamDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := netorca.TeamQuery{
+		Pov:      data.Pov.ValueString(),
+		TeamType: data.TeamType.ValueString(),
+		Name:     data.Name.ValueString(),
+	}
+
+	teams, err := c.client.TeamsGet(&query)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintln("Error getting teams"), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d teams", len(teams)))
+
+	var diags diag.Diagnostics
+	elems := []attr.Value{}
+	values := []map[string]interface{}{}
+
+	for _, v := range teams {
+		obj, d := getTerraformTeam(v)
+		diags.Append(d...)
+		elems = append(elems, obj)
+		values = append(values, teamValue(v))
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: teamAttrTypes}, elems)
+	diags.Append(d...)
+
+	teamsValue, d := tfvalues.DynamicFromStruct(values)
+	diags.Append(d...)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Teams = list
+	data.TeamsValue = teamsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// getTerraformTeam converts a team into a Terraform object, with its metadata as a JSON string.
+func getTerraformTeam(v netorca.Team) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metadata, err := json.Marshal(v.MetadataData())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling metadata from team id: %d", v.Id), err.Error())
+		return types.ObjectNull(teamAttrTypes), diags
+	}
+
+	obj, d := types.ObjectValue(teamAttrTypes, map[string]attr.Value{
+		"id":        types.Int64Value(v.Id),
+		"name":      types.StringValue(v.Name),
+		"team_type": types.StringValue(v.Type),
+		"metadata":  types.StringValue(string(metadata)),
+	})
+	diags.Append(d...)
+
+	return obj, diags
+}
+
+// teamValue returns the team with its metadata decoded, to be converted into a dynamic value.
+func teamValue(v netorca.Team) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        v.Id,
+		"name":      v.Name,
+		"team_type": v.Type,
+		"metadata":  v.MetadataData(),
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var teamAttrTypes = map[string]attr.Type{
+	"id":        types.Int64Type,
+	"name":      types.StringType,
+	"team_type": types.StringType,
+	"metadata":  types.StringType,
+}
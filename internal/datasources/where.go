@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-netorca/internal/where"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Type Definitions
+// -----------------------------------------------------------------------------
+
+type whereConditionData struct {
+	Path     types.String `tfsdk:"path"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	Values   types.List   `tfsdk:"values"`
+}
+
+// -----------------------------------------------------------------------------
+// Helper Methods and Functions
+// -----------------------------------------------------------------------------
+
+// whereBlock returns the schema of the where block, example is a path valid for the data source's objects.
+func whereBlock(example string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Conditions evaluated against each result after it is returned by NetOrca, only results matching every condition are kept. " +
+			"Every page of results matching `filters` is read and filtered, rather than only the first. " +
+			"Paths address the result's json as exposed in the `_value` attribute, e.g. `" + example + "`. " +
+			"Keys are separated by dots, `[n]` selects an array element, `[*]` every element and `[\"a.b\"]` a key containing dots.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{
+					MarkdownDescription: "The path to the value(s) to compare, an optional leading `$.` is ignored.",
+					Required:            true,
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: "How to compare the values found at `path` (equals|not_equals|in|not_in|contains|matches|exists|not_exists), defaults to `equals`. " +
+						"`contains` matches a substring or an array element and `matches` a regular expression. " +
+						"When `path` finds several values the condition holds if any of them match, or for `not_equals` and `not_in` if none do.",
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(where.Operators...),
+					},
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "The value to compare with. Json values are compared in their text form, e.g. `300`, `true`, `null`.",
+					Optional:            true,
+				},
+				"values": schema.ListAttribute{
+					MarkdownDescription: "The values to compare with when using `in` or `not_in`.",
+					Optional:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// getWhereConditions converts the where block into conditions, reporting invalid conditions against their block.
+func getWhereConditions(ctx context.Context, whereList types.List) ([]where.Condition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if whereList.IsNull() || whereList.IsUnknown() {
+		return nil, diags
+	}
+
+	var data []whereConditionData
+	diags.Append(whereList.ElementsAs(ctx, &data, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	conditions := []where.Condition{}
+	for i, v := range data {
+		values := []string{}
+		if !v.Value.IsNull() {
+			values = append(values, v.Value.ValueString())
+		}
+		if !v.Values.IsNull() {
+			var list []string
+			diags.Append(v.Values.ElementsAs(ctx, &list, false)...)
+			values = append(values, list...)
+		}
+
+		condition, err := where.NewCondition(v.Path.ValueString(), v.Operator.ValueString(), values)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("where").AtListIndex(i),
+				"Invalid where condition",
+				fmt.Sprintf("Condition %d is invalid: %s", i, err.Error()),
+			)
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, diags
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+
+// Package jsonpatch computes RFC 6902 JSON Patch documents between two decoded json values.
+package jsonpatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// Operation is a single RFC 6902 JSON Patch operation.
+type Operation struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+// MarshalJSON always writes value for add and replace operations, even when it is null, and never for remove.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	if o.Op == OpRemove {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+
+	return json.Marshal(struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value"`
+	}{o.Op, o.Path, o.Value})
+}
+
+// Diff returns the operations that transform from into to. Objects are compared key by key, any other differing value
+// (including arrays) is replaced as a whole. A null document is treated as an empty object when compared to an object.
+func Diff(from, to interface{}) []Operation {
+	from, to = normalise(from), normalise(to)
+
+	if from == nil {
+		if _, ok := to.(map[string]interface{}); ok {
+			from = map[string]interface{}{}
+		}
+	}
+	if to == nil {
+		if _, ok := from.(map[string]interface{}); ok {
+			to = map[string]interface{}{}
+		}
+	}
+
+	return diff("", from, to)
+}
+
+// DiffJSON marshals the operations between from and to, "[]" is returned when they are equal.
+func DiffJSON(from, to interface{}) (string, error) {
+	ops := Diff(from, to)
+	if ops == nil {
+		ops = []Operation{}
+	}
+
+	b, err := json.Marshal(ops)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func diff(path string, from, to interface{}) []Operation {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+
+	if fromIsMap && toIsMap {
+		keys := make([]string, 0, len(fromMap)+len(toMap))
+		for k := range fromMap {
+			keys = append(keys, k)
+		}
+		for k := range toMap {
+			if _, ok := fromMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		var ops []Operation
+		for _, k := range keys {
+			childPath := path + "/" + escape(k)
+			fromValue, inFrom := fromMap[k]
+			toValue, inTo := toMap[k]
+
+			switch {
+			case inFrom && !inTo:
+				ops = append(ops, Operation{Op: OpRemove, Path: childPath})
+			case !inFrom && inTo:
+				ops = append(ops, Operation{Op: OpAdd, Path: childPath, Value: toValue})
+			default:
+				ops = append(ops, diff(childPath, fromValue, toValue)...)
+			}
+		}
+		return ops
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+
+	return []Operation{{Op: OpReplace, Path: path, Value: to}}
+}
+
+// normalise round trips a value through encoding/json so that typed maps and structs compare like decoded json.
+func normalise(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return v
+	}
+
+	return out
+}
+
+// escape encodes an object key as a JSON Pointer reference token.
+func escape(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+
+package jsonpatch
+
+import (
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     interface{}
+		to       interface{}
+		expected string
+	}{
+		{
+			name:     "equal",
+			from:     map[string]interface{}{"zone": "example.com"},
+			to:       map[string]interface{}{"zone": "example.com"},
+			expected: `[]`,
+		},
+		{
+			name:     "create_from_null",
+			from:     nil,
+			to:       map[string]interface{}{"name": "vip1", "ttl": 300},
+			expected: `[{"op":"add","path":"/name","value":"vip1"},{"op":"add","path":"/ttl","value":300}]`,
+		},
+		{
+			name:     "delete_to_null",
+			from:     map[string]interface{}{"name": "vip1"},
+			to:       nil,
+			expected: `[{"op":"remove","path":"/name"}]`,
+		},
+		{
+			name: "modify_nested",
+			from: map[string]interface{}{
+				"name":      "vip1",
+				"addresses": []interface{}{"10.0.0.1"},
+				"options":   map[string]interface{}{"ttl": 300, "proxied": true},
+			},
+			to: map[string]interface{}{
+				"name":      "vip1",
+				"addresses": []interface{}{"10.0.0.1", "10.0.0.2"},
+				"options":   map[string]interface{}{"ttl": 60, "a/b~c": nil},
+			},
+			expected: `[{"op":"replace","path":"/addresses","value":["10.0.0.1","10.0.0.2"]},` +
+				`{"op":"add","path":"/options/a~1b~0c","value":null},` +
+				`{"op":"remove","path":"/options/proxied"},` +
+				`{"op":"replace","path":"/options/ttl","value":60}]`,
+		},
+		{
+			name:     "type_change",
+			from:     map[string]interface{}{"port": "80"},
+			to:       map[string]interface{}{"port": 80},
+			expected: `[{"op":"replace","path":"/port","value":80}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := DiffJSON(test.from, test.to)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result != test.expected {
+				t.Errorf("Expected: %s, Got: %s", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+func (c *NetOrcaClient) ApplicationGetById(id int64, pov string) (NetOrcaApplication, error) {
+
+	url := fmt.Sprintf("%s/v1/orcabase/%s/applications/%d/", c.baseUrl, pov, id)
+
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return NetOrcaApplication{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var application NetOrcaApplication
+
+	err = json.Unmarshal(b, &application)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	return application, nil
+}
+
+type NetOrcaApplications struct {
+	Count    int
+	Next     string
+	Previous string
+	Results  []NetOrcaApplication
+}
+
+// ApplicationRequest holds the fields of an application to create or update. Metadata is a json object, an empty
+// Metadata is sent as {} on create and left unchanged on update.
+type ApplicationRequest struct {
+	Name     string
+	Metadata string
+}
+
+type applicationJson struct {
+	Name     string                  `json:"name,omitempty"`
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type ApplicationQuery struct {
+	Pov   string
+	Name  string
+	Owner int64
+}
+
+// ApplicationCreate creates an application from the consumer POV, owned by the team of the API key.
+func (c *NetOrcaClient) ApplicationCreate(request ApplicationRequest) (NetOrcaApplication, error) {
+	if request.Metadata == "" {
+		request.Metadata = "{}"
+	}
+
+	url := fmt.Sprintf("%s/v1/orcabase/consumer/applications/", c.baseUrl)
+	return c.applicationSend("POST", url, request)
+}
+
+// ApplicationUpdate updates the name and metadata of an application from the consumer POV.
+func (c *NetOrcaClient) ApplicationUpdate(id int64, request ApplicationRequest) (NetOrcaApplication, error) {
+	url := fmt.Sprintf("%s/v1/orcabase/consumer/applications/%d/", c.baseUrl, id)
+	return c.applicationSend("PATCH", url, request)
+}
+
+// ApplicationDelete deletes an application from the consumer POV.
+func (c *NetOrcaClient) ApplicationDelete(id int64) error {
+	url := fmt.Sprintf("%s/v1/orcabase/consumer/applications/%d/", c.baseUrl, id)
+
+	serv, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return fmt.Errorf("http code: %d\nresponse: %s\nurl: %s\nmethod: DELETE", resp.StatusCode, b, url)
+	}
+
+	return nil
+}
+
+func (c *NetOrcaClient) applicationSend(method, url string, request ApplicationRequest) (NetOrcaApplication, error) {
+	content := applicationJson{Name: request.Name}
+
+	if request.Metadata != "" {
+		var metadata map[string]interface{}
+
+		err := json.Unmarshal([]byte(request.Metadata), &metadata)
+		if err != nil {
+			return NetOrcaApplication{}, err
+		}
+		if metadata == nil {
+			metadata = map[string]interface{}{}
+		}
+		content.Metadata = &metadata
+	}
+
+	body, err := json.Marshal(content)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	serv, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+	serv.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 201 {
+		return NetOrcaApplication{}, fmt.Errorf("http code: %d\nresponse: %s\nurl: %s\nmethod: %s", resp.StatusCode, b, url, method)
+	}
+
+	var application NetOrcaApplication
+
+	err = json.Unmarshal(b, &application)
+	if err != nil {
+		return NetOrcaApplication{}, err
+	}
+
+	return application, nil
+}
+
+// ApplicationsGet returns the applications matching the query from every page of results.
+func (c *NetOrcaClient) ApplicationsGet(q *ApplicationQuery) ([]NetOrcaApplication, error) {
+	url := fmt.Sprintf("%s/v1/orcabase/%s/applications/%s", c.baseUrl, q.Pov, q.GetQueryParam())
+
+	var results []NetOrcaApplication
+	for url != "" {
+		applications, err := c.applicationsGetPage(url)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, applications.Results...)
+		url = applications.Next
+	}
+
+	return results, nil
+}
+
+func (c *NetOrcaClient) applicationsGetPage(url string) (NetOrcaApplications, error) {
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return NetOrcaApplications{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return NetOrcaApplications{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return NetOrcaApplications{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return NetOrcaApplications{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var applications NetOrcaApplications
+
+	err = json.Unmarshal(b, &applications)
+	if err != nil {
+		return NetOrcaApplications{}, err
+	}
+
+	return applications, nil
+}
+
+// Returns the formatted query parmaters for use with the http client.
+// e.g. in the form of ?<field_name>=<field_value>&<field_name>=<field_value>
+func (q ApplicationQuery) GetQueryParam() string {
+	queryParam := "?"
+
+	if q.Name != "" {
+		queryParam = fmt.Sprintf("%sname=%s&", queryParam, url.QueryEscape(q.Name))
+	}
+
+	if q.Owner != 0 {
+		queryParam = fmt.Sprintf("%sowner=%d&", queryParam, q.Owner)
+	}
+
+	// Remove the trailing '&' if it exists
+	if queryParam[len(queryParam)-1] == '&' {
+		queryParam = queryParam[:len(queryParam)-1]
+	}
+
+	// If only '?' remains, return an empty string
+	if queryParam == "?" {
+		return ""
+	}
+
+	return queryParam
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestApplicationCreateUpdateDelete(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("Failed to read request body: %v", err)
+		}
+		if len(b) > 0 {
+			var body map[string]interface{}
+			if err := json.Unmarshal(b, &body); err != nil {
+				t.Fatalf("Failed to unmarshal request body: %v", err)
+			}
+			bodies = append(bodies, body)
+		}
+
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write([]byte(`{"id": 7, "name": "app7", "metadata": {}, "owner": 1}`))
+		case "PATCH":
+			w.WriteHeader(http.StatusOK)
+			_, err = w.Write([]byte(`{"id": 7, "name": "app7", "metadata": {"cost_centre": "cc-12"}, "owner": 1}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	created, err := client.ApplicationCreate(ApplicationRequest{Name: "app7"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.Id != 7 || created.Owner != 1 {
+		t.Errorf("Unexpected application %+v", created)
+	}
+
+	updated, err := client.ApplicationUpdate(7, ApplicationRequest{Metadata: `{"cost_centre": "cc-12"}`})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(updated.Metadata, map[string]interface{}{"cost_centre": "cc-12"}) {
+		t.Errorf("Unexpected metadata %v", updated.Metadata)
+	}
+
+	if err := client.ApplicationDelete(7); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedRequests := []string{
+		"POST /v1/orcabase/consumer/applications/",
+		"PATCH /v1/orcabase/consumer/applications/7/",
+		"DELETE /v1/orcabase/consumer/applications/7/",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("Expected requests %v, got %v", expectedRequests, requests)
+	}
+
+	expectedBodies := []map[string]interface{}{
+		{"name": "app7", "metadata": map[string]interface{}{}},
+		{"metadata": map[string]interface{}{"cost_centre": "cc-12"}},
+	}
+	if !reflect.DeepEqual(bodies, expectedBodies) {
+		t.Errorf("Expected bodies %v, got %v", expectedBodies, bodies)
+	}
+}
+
+func TestApplicationsGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/orcabase/consumer/applications/" || r.URL.RawQuery != "name=app+7" {
+			t.Errorf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 1, "next": null, "previous": null, "results": [{"id": 7, "name": "app 7", "metadata": {"cost_centre": "cc-12"}, "owner": 1}]}`))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	applications, err := client.ApplicationsGet(&ApplicationQuery{Pov: "consumer", Name: "app 7"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(applications) != 1 || applications[0].Id != 7 || applications[0].Name != "app 7" {
+		t.Errorf("Unexpected applications %+v", applications)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"terraform-provider-netorca/internal/where"
+)
+
+const (
+	ChangeInstanceBatchUpdated   = "updated"
+	ChangeInstanceBatchUnchanged = "unchanged"
+	ChangeInstanceBatchFailed    = "failed"
+)
+
+// ChangeInstanceBatchRequest is the update applied to every change instance of a batch. DeployedItem is a json
+// template, see RenderChangeInstanceTemplate.
+type ChangeInstanceBatchRequest struct {
+	State        string
+	Description  string
+	DeployedItem string
+}
+
+// ChangeInstanceBatchResult records what happened to one change instance of a batch.
+type ChangeInstanceBatchResult struct {
+	ChangeInstanceId int64
+	ServiceItemId    int64
+	ServiceItemName  string
+	PreviousState    string
+	State            string
+	Result           string
+	Error            string
+}
+
+// templatePlaceholder matches a {{ path }} placeholder of a deployed_item template.
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+// ChangeInstanceBatchUpdate moves every change instance to the requested state, running at most concurrency updates at
+// once. Change instances already in the requested state are left unchanged. A failed update doesn't stop the others,
+// it is recorded in its result instead. Results are returned in the order of changeInstances.
+func (c *NetOrcaClient) ChangeInstanceBatchUpdate(ctx context.Context, pov string, changeInstances []ChangeInstance, request ChangeInstanceBatchRequest, concurrency int) []ChangeInstanceBatchResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]ChangeInstanceBatchResult, len(changeInstances))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, changeInstance := range changeInstances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i] = c.changeInstanceBatchUpdateOne(ctx, pov, changeInstance, request)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (c *NetOrcaClient) changeInstanceBatchUpdateOne(ctx context.Context, pov string, changeInstance ChangeInstance, request ChangeInstanceBatchRequest) ChangeInstanceBatchResult {
+	result := ChangeInstanceBatchResult{
+		ChangeInstanceId: changeInstance.Id,
+		ServiceItemId:    changeInstance.ServiceItemField.Id,
+		ServiceItemName:  changeInstance.ServiceItemField.Name,
+		PreviousState:    changeInstance.State,
+		State:            changeInstance.State,
+	}
+
+	fail := func(err error) ChangeInstanceBatchResult {
+		result.Result = ChangeInstanceBatchFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fail(err)
+	}
+
+	if changeInstance.State == request.State {
+		result.Result = ChangeInstanceBatchUnchanged
+		return result
+	}
+
+	if err := ValidateChangeInstanceTransition(pov, changeInstance.State, request.State); err != nil {
+		return fail(err)
+	}
+
+	deployedItem, err := RenderChangeInstanceTemplate(request.DeployedItem, changeInstance)
+	if err != nil {
+		return fail(err)
+	}
+
+	err = c.ChangeInstancePatch(changeInstance.Id, pov, ChangeInstanceUpdateRequest{
+		State:        request.State,
+		Description:  request.Description,
+		DeployedItem: deployedItem,
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	result.State = request.State
+	result.Result = ChangeInstanceBatchUpdated
+	return result
+}
+
+// RenderChangeInstanceTemplate fills the {{ path }} placeholders of a json template with values from the change
+// instance, using the path syntax of where conditions e.g. {{ service_item.declaration.name }}. A string made of a
+// single placeholder is replaced by the value found, keeping its json type, or by an array when the path matches
+// several values. Placeholders within a longer string are replaced by the text form of a single value.
+func RenderChangeInstanceTemplate(template string, changeInstance ChangeInstance) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(template)))
+	decoder.UseNumber()
+
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return "", fmt.Errorf("invalid deployed_item template: %s", err.Error())
+	}
+
+	rendered, err := renderTemplateValue(doc, changeInstance)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(rendered)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func renderTemplateValue(v interface{}, changeInstance ChangeInstance) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			r, err := renderTemplateValue(e, changeInstance)
+			if err != nil {
+				return nil, err
+			}
+			rendered[k] = r
+		}
+		return rendered, nil
+	case []interface{}:
+		rendered := make([]interface{}, len(t))
+		for i, e := range t {
+			r, err := renderTemplateValue(e, changeInstance)
+			if err != nil {
+				return nil, err
+			}
+			rendered[i] = r
+		}
+		return rendered, nil
+	case string:
+		return renderTemplateString(t, changeInstance)
+	}
+
+	return v, nil
+}
+
+func renderTemplateString(s string, changeInstance ChangeInstance) (interface{}, error) {
+	if match := templatePlaceholder.FindStringSubmatchIndex(s); match != nil && match[0] == 0 && match[1] == len(s) {
+		found, err := lookupTemplatePath(s[match[2]:match[3]], changeInstance)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 1 {
+			return found[0], nil
+		}
+		return found, nil
+	}
+
+	var renderErr error
+	rendered := templatePlaceholder.ReplaceAllStringFunc(s, func(placeholder string) string {
+		path := templatePlaceholder.FindStringSubmatch(placeholder)[1]
+		found, err := lookupTemplatePath(path, changeInstance)
+		if err == nil && len(found) != 1 {
+			err = fmt.Errorf("template path %s matched %d values, a placeholder within a string needs exactly one", path, len(found))
+		}
+		if err != nil {
+			if renderErr == nil {
+				renderErr = err
+			}
+			return placeholder
+		}
+		return where.Text(found[0])
+	})
+	if renderErr != nil {
+		return nil, renderErr
+	}
+
+	return rendered, nil
+}
+
+func lookupTemplatePath(path string, changeInstance ChangeInstance) ([]interface{}, error) {
+	found, err := where.Lookup(changeInstance, path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template placeholder: %s", err.Error())
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("template path %s matched nothing in change instance id: %d", path, changeInstance.Id)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRenderChangeInstanceTemplate(t *testing.T) {
+	changeInstance := ChangeInstance{
+		Id: 53,
+		ServiceItemField: ServiceItem{
+			Id:   32,
+			Name: "django-app6",
+			Declaration: map[string]interface{}{
+				"name":      "django-app6",
+				"port":      8080,
+				"addresses": []interface{}{"10.0.0.1", "10.0.0.2"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "no_placeholders",
+			template: `{"deployed": true}`,
+			expected: `{"deployed":true}`,
+		},
+		{
+			name:     "whole_values",
+			template: `{"name": "{{ service_item.name }}", "port": "{{service_item.declaration.port}}", "addresses": "{{ service_item.declaration.addresses[*] }}"}`,
+			expected: `{"addresses":["10.0.0.1","10.0.0.2"],"name":"django-app6","port":8080}`,
+		},
+		{
+			name:     "within_string",
+			template: `{"url": "https://{{ service_item.name }}:{{ service_item.declaration.port }}/"}`,
+			expected: `{"url":"https://django-app6:8080/"}`,
+		},
+		{
+			name:     "missing_path",
+			template: `{"zone": "{{ service_item.declaration.zone }}"}`,
+			errMsg:   "template path service_item.declaration.zone matched nothing in change instance id: 53",
+		},
+		{
+			name:     "several_values_within_string",
+			template: `{"address": "ip {{ service_item.declaration.addresses[*] }}"}`,
+			errMsg:   "template path service_item.declaration.addresses[*] matched 2 values, a placeholder within a string needs exactly one",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := RenderChangeInstanceTemplate(test.template, changeInstance)
+			if test.errMsg != "" {
+				if err == nil || err.Error() != test.errMsg {
+					t.Fatalf("Expected error %s, got %v", test.errMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestChangeInstanceBatchUpdate(t *testing.T) {
+	var mu sync.Mutex
+	patched := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/3/") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		patched[r.URL.Path] = r.Method
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	changeInstances := []ChangeInstance{
+		{Id: 1, State: ChangeInstanceStatePending},
+		{Id: 2, State: ChangeInstanceStateApproved},
+		{Id: 3, State: ChangeInstanceStatePending},
+		{Id: 4, State: ChangeInstanceStateCompleted},
+	}
+	request := ChangeInstanceBatchRequest{State: ChangeInstanceStateApproved, Description: "Approved", DeployedItem: `{"deployed": true}`}
+
+	results := client.ChangeInstanceBatchUpdate(context.Background(), PovServiceOwner, changeInstances, request, 2)
+
+	expected := []string{ChangeInstanceBatchUpdated, ChangeInstanceBatchUnchanged, ChangeInstanceBatchFailed, ChangeInstanceBatchFailed}
+	for i, result := range results {
+		if result.ChangeInstanceId != changeInstances[i].Id {
+			t.Errorf("Expected result %d for change instance id: %d, got %d", i, changeInstances[i].Id, result.ChangeInstanceId)
+		}
+		if result.Result != expected[i] {
+			t.Errorf("Change instance id: %d expected result %s, got %s (%s)", result.ChangeInstanceId, expected[i], result.Result, result.Error)
+		}
+	}
+
+	if results[0].State != ChangeInstanceStateApproved || results[0].PreviousState != ChangeInstanceStatePending {
+		t.Errorf("Expected change instance id: 1 to move from PENDING to APPROVED, got %s to %s", results[0].PreviousState, results[0].State)
+	}
+	if !strings.HasPrefix(results[2].Error, "http code: 400") {
+		t.Errorf("Expected the http error for change instance id: 3, got %s", results[2].Error)
+	}
+	if !strings.HasPrefix(results[3].Error, "state can't move from COMPLETED to APPROVED") {
+		t.Errorf("Expected a transition error for change instance id: 4, got %s", results[3].Error)
+	}
+	if len(patched) != 1 || patched["/v1/orcabase/serviceowner/change_instances/1/"] != http.MethodPatch {
+		t.Errorf("Expected only change instance id: 1 to be patched, got %v", patched)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	ChangeInstanceStatePending   = "PENDING"
+	ChangeInstanceStateApproved  = "APPROVED"
+	ChangeInstanceStateRejected  = "REJECTED"
+	ChangeInstanceStateCompleted = "COMPLETED"
+	ChangeInstanceStateError     = "ERROR"
+	ChangeInstanceStateClosed    = "CLOSED"
+)
+
+// ChangeInstanceStates lists every state a change instance can be in.
+var ChangeInstanceStates = []string{
+	ChangeInstanceStatePending,
+	ChangeInstanceStateApproved,
+	ChangeInstanceStateRejected,
+	ChangeInstanceStateCompleted,
+	ChangeInstanceStateError,
+	ChangeInstanceStateClosed,
+}
+
+// changeInstanceStateTransitions maps each POV and state to the states that POV may move a change instance to. The
+// consumer POV can't change the state of a change instance.
+var changeInstanceStateTransitions = map[string]map[string][]string{
+	PovServiceOwner: {
+		ChangeInstanceStatePending:   {ChangeInstanceStateApproved, ChangeInstanceStateRejected},
+		ChangeInstanceStateApproved:  {ChangeInstanceStateCompleted, ChangeInstanceStateError, ChangeInstanceStateRejected},
+		ChangeInstanceStateError:     {ChangeInstanceStateApproved, ChangeInstanceStateCompleted, ChangeInstanceStateRejected},
+		ChangeInstanceStateCompleted: {},
+		ChangeInstanceStateRejected:  {},
+		ChangeInstanceStateClosed:    {},
+	},
+	PovConsumer: {
+		ChangeInstanceStatePending:   {},
+		ChangeInstanceStateApproved:  {},
+		ChangeInstanceStateError:     {},
+		ChangeInstanceStateCompleted: {},
+		ChangeInstanceStateRejected:  {},
+		ChangeInstanceStateClosed:    {},
+	},
+}
+
+// AllowedChangeInstanceTransitions returns the states a POV can move a change instance to from the given state.
+func AllowedChangeInstanceTransitions(pov, from string) []string {
+	return changeInstanceStateTransitions[pov][from]
+}
+
+// ValidateChangeInstanceTransition returns an error if a POV can't move a change instance from one state to another.
+// Staying in the same state is always valid.
+func ValidateChangeInstanceTransition(pov, from, to string) error {
+	if !slices.Contains(ChangeInstanceStates, to) {
+		return fmt.Errorf("unknown state %s, expected one of: %s", to, strings.Join(ChangeInstanceStates, ", "))
+	}
+
+	transitions, ok := changeInstanceStateTransitions[pov]
+	if !ok {
+		return fmt.Errorf("unknown pov %s, expected one of: %s", pov, strings.Join(Povs, ", "))
+	}
+
+	if from == to {
+		return nil
+	}
+
+	allowed, ok := transitions[from]
+	if !ok {
+		return fmt.Errorf("unknown current state %s, expected one of: %s", from, strings.Join(ChangeInstanceStates, ", "))
+	}
+
+	if slices.Contains(allowed, to) {
+		return nil
+	}
+
+	if len(allowed) == 0 {
+		return fmt.Errorf("state can't move from %s to %s from the %s POV, %s has no allowed next states", from, to, pov, from)
+	}
+
+	return fmt.Errorf("state can't move from %s to %s from the %s POV, allowed next states: %s", from, to, pov, strings.Join(allowed, ", "))
+}
+
+// changeInstanceStateProgressions maps each state to the states a change instance reaches by carrying on towards
+// completion from it. Failures and rework, e.g. APPROVED to REJECTED or ERROR, aren't progress.
+var changeInstanceStateProgressions = map[string][]string{
+	ChangeInstanceStateApproved: {ChangeInstanceStateCompleted},
+}
+
+// ChangeInstanceStateProgressed reports whether a change instance in state current has only progressed towards
+// completion since it was set to state from, e.g. APPROVED to COMPLETED.
+func ChangeInstanceStateProgressed(from, current string) bool {
+	return slices.Contains(changeInstanceStateProgressions[from], current)
+}
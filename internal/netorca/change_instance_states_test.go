@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"testing"
+)
+
+func TestValidateChangeInstanceTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		pov    string
+		from   string
+		to     string
+		errMsg string
+	}{
+		{
+			name: "pending_to_approved",
+			pov:  PovServiceOwner,
+			from: ChangeInstanceStatePending,
+			to:   ChangeInstanceStateApproved,
+		},
+		{
+			name: "approved_to_completed",
+			pov:  PovServiceOwner,
+			from: ChangeInstanceStateApproved,
+			to:   ChangeInstanceStateCompleted,
+		},
+		{
+			name: "error_to_approved",
+			pov:  PovServiceOwner,
+			from: ChangeInstanceStateError,
+			to:   ChangeInstanceStateApproved,
+		},
+		{
+			name: "unchanged",
+			pov:  PovConsumer,
+			from: ChangeInstanceStateCompleted,
+			to:   ChangeInstanceStateCompleted,
+		},
+		{
+			name:   "completed_to_pending",
+			pov:    PovServiceOwner,
+			from:   ChangeInstanceStateCompleted,
+			to:     ChangeInstanceStatePending,
+			errMsg: "state can't move from COMPLETED to PENDING from the serviceowner POV, COMPLETED has no allowed next states",
+		},
+		{
+			name:   "pending_to_completed",
+			pov:    PovServiceOwner,
+			from:   ChangeInstanceStatePending,
+			to:     ChangeInstanceStateCompleted,
+			errMsg: "state can't move from PENDING to COMPLETED from the serviceowner POV, allowed next states: APPROVED, REJECTED",
+		},
+		{
+			name:   "consumer_approves",
+			pov:    PovConsumer,
+			from:   ChangeInstanceStatePending,
+			to:     ChangeInstanceStateApproved,
+			errMsg: "state can't move from PENDING to APPROVED from the consumer POV, PENDING has no allowed next states",
+		},
+		{
+			name:   "unknown_target_state",
+			pov:    PovServiceOwner,
+			from:   ChangeInstanceStatePending,
+			to:     "DONE",
+			errMsg: "unknown state DONE, expected one of: PENDING, APPROVED, REJECTED, COMPLETED, ERROR, CLOSED",
+		},
+		{
+			name:   "unknown_current_state",
+			pov:    PovServiceOwner,
+			from:   "DONE",
+			to:     ChangeInstanceStateApproved,
+			errMsg: "unknown current state DONE, expected one of: PENDING, APPROVED, REJECTED, COMPLETED, ERROR, CLOSED",
+		},
+		{
+			name:   "unknown_pov",
+			pov:    "admin",
+			from:   ChangeInstanceStatePending,
+			to:     ChangeInstanceStateApproved,
+			errMsg: "unknown pov admin, expected one of: serviceowner, consumer",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateChangeInstanceTransition(test.pov, test.from, test.to)
+			if test.errMsg == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Expected error %s, got nil", test.errMsg)
+			}
+			if err.Error() != test.errMsg {
+				t.Errorf("Expected error %s, got %s", test.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestChangeInstanceStateProgressed(t *testing.T) {
+	tests := []struct {
+		from     string
+		current  string
+		expected bool
+	}{
+		{ChangeInstanceStateApproved, ChangeInstanceStateCompleted, true},
+		{ChangeInstanceStateApproved, ChangeInstanceStateError, false},
+		{ChangeInstanceStateApproved, ChangeInstanceStateRejected, false},
+		{ChangeInstanceStateError, ChangeInstanceStateApproved, false},
+		{ChangeInstanceStateError, ChangeInstanceStateCompleted, false},
+		{ChangeInstanceStatePending, ChangeInstanceStateCompleted, false},
+		{ChangeInstanceStateApproved, ChangeInstanceStatePending, false},
+		{ChangeInstanceStateCompleted, ChangeInstanceStateApproved, false},
+		{ChangeInstanceStateApproved, ChangeInstanceStateApproved, false},
+	}
+
+	for _, test := range tests {
+		if result := ChangeInstanceStateProgressed(test.from, test.current); result != test.expected {
+			t.Errorf("ChangeInstanceStateProgressed(%s, %s): expected %t, got %t", test.from, test.current, test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ChangeInstanceFailedStates are the states after which a change instance won't be carried out.
+var ChangeInstanceFailedStates = []string{ChangeInstanceStateRejected, ChangeInstanceStateError}
+
+// The wait between polls starts at changeInstanceWaitMinInterval and doubles up to changeInstanceWaitMaxInterval.
+var (
+	changeInstanceWaitMinInterval = 2 * time.Second
+	changeInstanceWaitMaxInterval = 30 * time.Second
+)
+
+// ChangeInstanceWait polls a change instance until it reaches one of the target states. It fails when the change
+// instance reaches a failed state that isn't a target, or when ctx is done.
+func (c *NetOrcaClient) ChangeInstanceWait(ctx context.Context, id int64, pov string, states []string) (ChangeInstance, error) {
+	interval := changeInstanceWaitMinInterval
+
+	for {
+		changeInstance, err := c.ChangeInstanceGetById(id, pov)
+		if err != nil {
+			return ChangeInstance{}, err
+		}
+
+		if slices.Contains(states, changeInstance.State) {
+			return changeInstance, nil
+		}
+		if slices.Contains(ChangeInstanceFailedStates, changeInstance.State) {
+			return changeInstance, fmt.Errorf("change instance id: %d reached state %s while waiting for %s", id, changeInstance.State, strings.Join(states, "|"))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Change instance id: %d is %s, waiting %s for %s", id, changeInstance.State, interval, strings.Join(states, "|")))
+
+		select {
+		case <-ctx.Done():
+			return changeInstance, fmt.Errorf("timed out waiting for change instance id: %d to reach %s, last state: %s", id, strings.Join(states, "|"), changeInstance.State)
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > changeInstanceWaitMaxInterval {
+			interval = changeInstanceWaitMaxInterval
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newChangeInstanceStateServer(t *testing.T, states []string) *httptest.Server {
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := states[len(states)-1]
+		if polls < len(states) {
+			state = states[polls]
+		}
+		polls++
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(fmt.Sprintf(`{"id": 53, "state": %q}`, state)))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+}
+
+func TestChangeInstanceWait(t *testing.T) {
+	changeInstanceWaitMinInterval = time.Millisecond
+	changeInstanceWaitMaxInterval = 2 * time.Millisecond
+
+	tests := []struct {
+		name     string
+		states   []string
+		timeout  time.Duration
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "reaches_target",
+			states:   []string{"PENDING", "APPROVED", "COMPLETED"},
+			timeout:  time.Second,
+			expected: "COMPLETED",
+		},
+		{
+			name:     "fails_on_rejected",
+			states:   []string{"PENDING", "REJECTED"},
+			timeout:  time.Second,
+			expected: "REJECTED",
+			errMsg:   "change instance id: 53 reached state REJECTED while waiting for COMPLETED",
+		},
+		{
+			name:     "times_out",
+			states:   []string{"PENDING"},
+			timeout:  10 * time.Millisecond,
+			expected: "PENDING",
+			errMsg:   "timed out waiting for change instance id: 53 to reach COMPLETED, last state: PENDING",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newChangeInstanceStateServer(t, test.states)
+			defer server.Close()
+
+			apikey := "123456"
+			client := NewClient(&server.URL, &apikey, context.Background())
+
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			result, err := client.ChangeInstanceWait(ctx, 53, "consumer", []string{"COMPLETED"})
+			if test.errMsg == "" && err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if test.errMsg != "" && (err == nil || err.Error() != test.errMsg) {
+				t.Fatalf("Expected error %s, got %v", test.errMsg, err)
+			}
+			if result.State != test.expected {
+				t.Errorf("Expected state %s, got %s", test.expected, result.State)
+			}
+		})
+	}
+}
@@ -8,19 +8,111 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"terraform-provider-netorca/internal/jsonpatch"
+)
+
+const (
+	ChangeTypeCreate = "CREATE"
+	ChangeTypeModify = "MODIFY"
+	ChangeTypeDelete = "DELETE"
 )
 
 type ChangeInstance struct {
-	Id               int64                      `json:"id"`
-	Url              string                     `json:"url"`
-	State            string                     `json:"state"`
-	Created          string                     `json:"created"`
-	Modified         string                     `json:"modified"`
-	Owner            ChangeInstanceOwner        `json:"owner"`
-	ConsumerTeam     ChangeInstanceConsumerTeam `json:"consumer_team"`
-	ServiceOwnerTeam interface{}                `json:"service_owner_team"`
-	Submission       ChangeInstanceSubmission   `json:"submission"`
-	ServiceItemField ServiceItem                `json:"service_item"`
+	Id               int64                          `json:"id"`
+	Url              string                         `json:"url"`
+	State            string                         `json:"state"`
+	Created          string                         `json:"created"`
+	Modified         string                         `json:"modified"`
+	ChangeType       string                         `json:"change_type"`
+	Description      string                         `json:"description"`
+	Log              string                         `json:"log"`
+	Owner            ChangeInstanceOwner            `json:"owner"`
+	ConsumerTeam     ChangeInstanceConsumerTeam     `json:"consumer_team"`
+	ServiceOwnerTeam ChangeInstanceServiceOwnerTeam `json:"service_owner_team"`
+	Submission       ChangeInstanceSubmission       `json:"submission"`
+	ServiceItemField ServiceItem                    `json:"service_item"`
+	NewDeclaration   *ChangeInstanceDeclaration     `json:"new_declaration"`
+	OldDeclaration   *ChangeInstanceDeclaration     `json:"old_declaration"`
+}
+
+// LastDescription returns the most recent description NetOrca recorded for the change instance. When NetOrca doesn't
+// return the description it is guessed from the last non-empty line of the log, which is best-effort: only the last
+// line of a multi-line description is returned.
+func (c ChangeInstance) LastDescription() string {
+	if c.Description != "" {
+		return c.Description
+	}
+
+	lines := strings.Split(strings.TrimSpace(c.Log), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
+
+// OldDeclarationData returns the declaration before the change, nil for a CREATE.
+func (c ChangeInstance) OldDeclarationData() map[string]interface{} {
+	if c.OldDeclaration == nil {
+		return nil
+	}
+	return c.OldDeclaration.Declaration
+}
+
+// NewDeclarationData returns the declaration after the change, nil for a DELETE.
+func (c ChangeInstance) NewDeclarationData() map[string]interface{} {
+	if c.NewDeclaration == nil {
+		return nil
+	}
+	return c.NewDeclaration.Declaration
+}
+
+// DeclarationDiff returns the JSON Patch operations that transform the old declaration into the new one. A missing
+// declaration is treated as an empty object, so a CREATE adds and a DELETE removes every key.
+func (c ChangeInstance) DeclarationDiff() []jsonpatch.Operation {
+	ops := jsonpatch.Diff(c.OldDeclarationData(), c.NewDeclarationData())
+	if ops == nil {
+		ops = []jsonpatch.Operation{}
+	}
+	return ops
+}
+
+// ParseChangeInstanceID parses a change instance ID of the form {pov}/{id}, or a bare {id} which is given defaultPov.
+func ParseChangeInstanceID(id string, defaultPov string) (string, int64, error) {
+	tokens := strings.Split(strings.TrimSpace(id), "/")
+
+	var pov, rawId string
+	switch len(tokens) {
+	case 1:
+		pov, rawId = defaultPov, tokens[0]
+	case 2:
+		pov, rawId = tokens[0], tokens[1]
+	default:
+		return "", 0, fmt.Errorf("%q has %d parts, expected {pov}/{id} or {id}", id, len(tokens))
+	}
+
+	if !slices.Contains(Povs, pov) {
+		return "", 0, fmt.Errorf("unknown pov %q, expected one of: %s", pov, strings.Join(Povs, ", "))
+	}
+
+	changeInstanceId, err := strconv.ParseInt(rawId, 10, 64)
+	if err != nil || changeInstanceId <= 0 {
+		return "", 0, fmt.Errorf("change instance id %q is not a positive integer", rawId)
+	}
+
+	return pov, changeInstanceId, nil
+}
+
+type ChangeInstanceDeclaration struct {
+	Version     int64                  `json:"version"`
+	Declaration map[string]interface{} `json:"declaration"`
 }
 
 type ChangeInstanceSubmission struct {
@@ -34,6 +126,12 @@ type ChangeInstanceConsumerTeam struct {
 	Metadata interface{} `json:"metadata"`
 }
 
+type ChangeInstanceServiceOwnerTeam struct {
+	Id       int64       `json:"id"`
+	Name     string      `json:"name"`
+	Metadata interface{} `json:"metadata"`
+}
+
 type ChangeInstanceOwner struct {
 	Id   int64  `json:"id"`
 	Name string `json:"name"`
@@ -70,24 +168,27 @@ type ChangeInstanceUpdateRequest struct {
 }
 
 type ChangeInstanceUpdateJson struct {
-	State        string                 `json:"state"`
-	Description  string                 `json:"description"`
-	DeployedItem map[string]interface{} `json:"deployed_item"`
+	State        string                  `json:"state,omitempty"`
+	Description  string                  `json:"description"`
+	DeployedItem *map[string]interface{} `json:"deployed_item,omitempty"`
 }
 
 func (c *NetOrcaClient) ChangeInstancePatch(id int64, pov string, request ChangeInstanceUpdateRequest) error {
 	url := fmt.Sprintf("%s/v1/orcabase/%s/change_instances/%d/", c.baseUrl, pov, id)
-	var deployedItem map[string]interface{}
-
-	err := json.Unmarshal([]byte(request.DeployedItem), &deployedItem)
-	if err != nil {
-		return err
+	content := ChangeInstanceUpdateJson{
+		State:       request.State,
+		Description: request.Description,
 	}
 
-	content := ChangeInstanceUpdateJson{
-		State:        request.State,
-		Description:  request.Description,
-		DeployedItem: deployedItem,
+	// An empty deployed_item leaves the one NetOrca holds unchanged.
+	if request.DeployedItem != "" {
+		var deployedItem map[string]interface{}
+
+		err := json.Unmarshal([]byte(request.DeployedItem), &deployedItem)
+		if err != nil {
+			return err
+		}
+		content.DeployedItem = &deployedItem
 	}
 
 	json, err := json.Marshal(content)
@@ -133,6 +234,29 @@ func (c *NetOrcaClient) ChangeInstanceGet(q *ChangeInstanceQuery) (NetOrcaChange
 		url = fmt.Sprintf("%s%s", url, queryParameters)
 	}
 
+	return c.changeInstanceGetPage(url)
+}
+
+// ChangeInstanceGetAll returns the change instances matching the query from every page of results.
+func (c *NetOrcaClient) ChangeInstanceGetAll(q *ChangeInstanceQuery) ([]ChangeInstance, error) {
+	changeInstances, err := c.ChangeInstanceGet(q)
+	if err != nil {
+		return nil, err
+	}
+
+	results := changeInstances.Results
+	for next := changeInstances.Next; next != ""; next = changeInstances.Next {
+		changeInstances, err = c.changeInstanceGetPage(next)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, changeInstances.Results...)
+	}
+
+	return results, nil
+}
+
+func (c *NetOrcaClient) changeInstanceGetPage(url string) (NetOrcaChangeInstance, error) {
 	serv, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return NetOrcaChangeInstance{}, err
@@ -304,6 +428,22 @@ func NewChangeInstanceQuery(args map[string]interface{}) (*ChangeInstanceQuery,
 func (q ChangeInstanceQuery) GetQueryParam() string {
 	queryParam := "?"
 
+	if q.ApplicationId != 0 {
+		queryParam = fmt.Sprintf("%sapplication_id=%d&", queryParam, q.ApplicationId)
+	}
+
+	if q.ChangeType != "" {
+		queryParam = fmt.Sprintf("%schange_type=%s&", queryParam, url.QueryEscape(q.ChangeType))
+	}
+
+	if q.CommitId != "" {
+		queryParam = fmt.Sprintf("%scommit_id=%s&", queryParam, url.QueryEscape(q.CommitId))
+	}
+
+	if q.ConsumerTeamId != 0 {
+		queryParam = fmt.Sprintf("%sconsumer_team_id=%d&", queryParam, q.ConsumerTeamId)
+	}
+
 	if q.ServiceId != 0 {
 		queryParam = fmt.Sprintf("%sservice_id=%d&", queryParam, q.ServiceId)
 	}
@@ -313,7 +453,7 @@ func (q ChangeInstanceQuery) GetQueryParam() string {
 	}
 
 	if q.ServiceName != "" {
-		queryParam = fmt.Sprintf("%sservice_name=%s&", queryParam, q.ServiceName)
+		queryParam = fmt.Sprintf("%sservice_name=%s&", queryParam, url.QueryEscape(q.ServiceName))
 	}
 
 	if q.ServiceOwnerTeamId != 0 {
@@ -321,7 +461,7 @@ func (q ChangeInstanceQuery) GetQueryParam() string {
 	}
 
 	if q.State != "" {
-		queryParam = fmt.Sprintf("%sstate=%s&", queryParam, q.State)
+		queryParam = fmt.Sprintf("%sstate=%s&", queryParam, url.QueryEscape(q.State))
 	}
 
 	if q.SubmissionId != 0 {
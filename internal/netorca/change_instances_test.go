@@ -213,6 +213,42 @@ func TestGetQueryParams(t *testing.T) {
 			},
 			expected: "?service_id=1&service_item_id=2&service_name=test_service&service_owner_team_id=3&state=active&submission_id=4",
 		},
+		{
+			name:     "application_id",
+			query:    ChangeInstanceQuery{ApplicationId: 7},
+			expected: "?application_id=7",
+		},
+		{
+			name:     "change_type",
+			query:    ChangeInstanceQuery{ChangeType: "DELETE"},
+			expected: "?change_type=DELETE",
+		},
+		{
+			name:     "commit_id",
+			query:    ChangeInstanceQuery{CommitId: "release 1.2&main"},
+			expected: "?commit_id=release+1.2%26main",
+		},
+		{
+			name:     "consumer_team_id",
+			query:    ChangeInstanceQuery{ConsumerTeamId: 5},
+			expected: "?consumer_team_id=5",
+		},
+		{
+			name:     "escaped_service_name",
+			query:    ChangeInstanceQuery{ServiceName: "a&b"},
+			expected: "?service_name=a%26b",
+		},
+		{
+			name: "filters_with_service_id",
+			query: ChangeInstanceQuery{
+				ApplicationId:  7,
+				ChangeType:     "MODIFY",
+				CommitId:       "abc123",
+				ConsumerTeamId: 5,
+				ServiceId:      1,
+			},
+			expected: "?application_id=7&change_type=MODIFY&commit_id=abc123&consumer_team_id=5&service_id=1",
+		},
 		{
 			name: "some_fields_populated",
 			query: ChangeInstanceQuery{
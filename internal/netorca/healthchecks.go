@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type Healthcheck struct {
+	Id          int64                  `json:"id"`
+	ServiceItem HealthcheckServiceItem `json:"service_item"`
+	Status      int64                  `json:"status"`
+	Message     string                 `json:"message"`
+	Created     string                 `json:"created"`
+}
+
+type HealthcheckServiceItem struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	ServiceName string `json:"service_name"`
+}
+
+type NetOrcaHealthcheck struct {
+	Count    int
+	Next     string
+	Previous string
+	Results  []Healthcheck
+}
+
+type HealthcheckQuery struct {
+	Pov                string
+	ServiceId          int64
+	ServiceName        string
+	ConsumerTeamId     int64
+	ServiceOwnerTeamId int64
+}
+
+// HealthchecksGet returns the healthchecks matching the query from every page of results.
+func (c *NetOrcaClient) HealthchecksGet(q *HealthcheckQuery) ([]Healthcheck, error) {
+	url := fmt.Sprintf("%s/v1/orcabase/%s/healthchecks/%s", c.baseUrl, q.Pov, q.GetQueryParam())
+
+	var results []Healthcheck
+	for url != "" {
+		healthchecks, err := c.healthchecksGetPage(url)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, healthchecks.Results...)
+		url = healthchecks.Next
+	}
+
+	return results, nil
+}
+
+func (c *NetOrcaClient) healthchecksGetPage(url string) (NetOrcaHealthcheck, error) {
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return NetOrcaHealthcheck{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return NetOrcaHealthcheck{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return NetOrcaHealthcheck{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return NetOrcaHealthcheck{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var healthchecks NetOrcaHealthcheck
+
+	err = json.Unmarshal(b, &healthchecks)
+	if err != nil {
+		return NetOrcaHealthcheck{}, err
+	}
+
+	return healthchecks, nil
+}
+
+// Returns the formatted query parmaters for use with the http client.
+// e.g. in the form of ?<field_name>=<field_value>&<field_name>=<field_value>
+func (q HealthcheckQuery) GetQueryParam() string {
+	queryParam := "?"
+
+	if q.ServiceId != 0 {
+		queryParam = fmt.Sprintf("%sservice_id=%d&", queryParam, q.ServiceId)
+	}
+
+	if q.ServiceName != "" {
+		queryParam = fmt.Sprintf("%sservice_name=%s&", queryParam, url.QueryEscape(q.ServiceName))
+	}
+
+	if q.ConsumerTeamId != 0 {
+		queryParam = fmt.Sprintf("%sconsumer_team_id=%d&", queryParam, q.ConsumerTeamId)
+	}
+
+	if q.ServiceOwnerTeamId != 0 {
+		queryParam = fmt.Sprintf("%sservice_owner_team_id=%d&", queryParam, q.ServiceOwnerTeamId)
+	}
+
+	// Remove the trailing '&' if it exists
+	if queryParam[len(queryParam)-1] == '&' {
+		queryParam = queryParam[:len(queryParam)-1]
+	}
+
+	// If only '?' remains, return an empty string
+	if queryParam == "?" {
+		return ""
+	}
+
+	return queryParam
+}
+
+// LatestHealthchecks keeps the most recent healthcheck of each service item, ordered by service item id. Healthchecks
+// created at the same time, or whose created time can't be parsed, are ordered by id.
+func LatestHealthchecks(healthchecks []Healthcheck) []Healthcheck {
+	latest := map[int64]Healthcheck{}
+	for _, v := range healthchecks {
+		current, ok := latest[v.ServiceItem.Id]
+		if !ok {
+			latest[v.ServiceItem.Id] = v
+			continue
+		}
+
+		created, currentCreated := v.createdTime(), current.createdTime()
+		if created.After(currentCreated) || (created.Equal(currentCreated) && v.Id > current.Id) {
+			latest[v.ServiceItem.Id] = v
+		}
+	}
+
+	results := make([]Healthcheck, 0, len(latest))
+	for _, v := range latest {
+		results = append(results, v)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].ServiceItem.Id < results[j].ServiceItem.Id })
+
+	return results
+}
+
+// createdTime parses the time the healthcheck was created, the zero time when it can't be parsed.
+func (h Healthcheck) createdTime() time.Time {
+	created, err := time.Parse(time.RFC3339Nano, h.Created)
+	if err != nil {
+		return time.Time{}
+	}
+	return created
+}
+
+// CountHealthchecksByStatus returns the number of healthchecks for each status, keyed by the status as a string.
+func CountHealthchecksByStatus(healthchecks []Healthcheck) map[string]int64 {
+	counts := map[string]int64{}
+	for _, v := range healthchecks {
+		counts[strconv.FormatInt(v.Status, 10)]++
+	}
+
+	return counts
+}
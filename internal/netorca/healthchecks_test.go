@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestHealthchecksGet(t *testing.T) {
+	mockResponse, err := os.ReadFile("testdata/healthchecks_200.json")
+	if err != nil {
+		t.Fatalf("Failed to read mock response file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/orcabase/serviceowner/healthchecks/" || r.URL.RawQuery != "service_name=THREE_TIER_APPLICATION" {
+			t.Errorf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(mockResponse)
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	healthchecks, err := client.HealthchecksGet(&HealthcheckQuery{Pov: "serviceowner", ServiceName: "THREE_TIER_APPLICATION"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(healthchecks) != 3 {
+		t.Fatalf("Expected 3 healthchecks, got %d", len(healthchecks))
+	}
+
+	latest := LatestHealthchecks(healthchecks)
+	if len(latest) != 2 || latest[0].Id != 9 || latest[1].Id != 8 {
+		t.Errorf("Expected latest healthchecks 9 and 8, got %+v", latest)
+	}
+
+	expected := map[string]int64{"200": 1, "503": 1}
+	if counts := CountHealthchecksByStatus(latest); !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected counts %v, got %v", expected, counts)
+	}
+}
+
+func TestHealthcheckQueryGetQueryParam(t *testing.T) {
+	q := HealthcheckQuery{Pov: "consumer", ServiceId: 4, ConsumerTeamId: 1}
+	if result := q.GetQueryParam(); result != "?service_id=4&consumer_team_id=1" {
+		t.Errorf("Expected ?service_id=4&consumer_team_id=1, got %s", result)
+	}
+
+	q = HealthcheckQuery{Pov: "consumer", ServiceName: "a record&zone"}
+	if result := q.GetQueryParam(); result != "?service_name=a+record%26zone" {
+		t.Errorf("Expected ?service_name=a+record%%26zone, got %s", result)
+	}
+
+	if result := (HealthcheckQuery{Pov: "consumer"}).GetQueryParam(); result != "" {
+		t.Errorf("Expected an empty query, got %s", result)
+	}
+}
+
+func TestLatestHealthchecks(t *testing.T) {
+	healthchecks := []Healthcheck{
+		{Id: 1, ServiceItem: HealthcheckServiceItem{Id: 2}, Created: "2024-05-01T10:00:00.5Z"},
+		{Id: 2, ServiceItem: HealthcheckServiceItem{Id: 2}, Created: "2024-05-01T10:00:00Z"},
+		{Id: 3, ServiceItem: HealthcheckServiceItem{Id: 1}, Created: "2024-05-01T10:00:00Z"},
+		{Id: 4, ServiceItem: HealthcheckServiceItem{Id: 1}, Created: "2024-05-01T12:00:00.5+02:00"},
+		{Id: 5, ServiceItem: HealthcheckServiceItem{Id: 3}, Created: "2024-05-01T10:00:00Z"},
+		{Id: 6, ServiceItem: HealthcheckServiceItem{Id: 3}, Created: "2024-05-01T10:00:00Z"},
+	}
+
+	result := LatestHealthchecks(healthchecks)
+
+	ids := []int64{}
+	for _, v := range result {
+		ids = append(ids, v.Id)
+	}
+	if len(ids) != 3 || ids[0] != 4 || ids[1] != 1 || ids[2] != 6 {
+		t.Errorf("Expected healthchecks 4, 1 and 6, got %v", ids)
+	}
+}
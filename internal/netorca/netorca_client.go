@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,6 +17,9 @@ type NetOrcaClient struct {
 	baseUrl string
 	client  *http.Client
 	apiKey  string
+
+	// applicationLocks holds a *sync.Mutex per application id, see LockApplication.
+	applicationLocks *sync.Map
 }
 
 func formatApiKey(apikey string) string {
@@ -42,5 +47,12 @@ func NewClient(url, apikey *string, ctx context.Context) *NetOrcaClient {
 		client:  &httpClient,
 		baseUrl: *url,
 		apiKey:  formatApiKey(*apikey),
+
+		applicationLocks: &sync.Map{},
 	}
 }
+
+// IsNotFound reports whether err is a 404 response returned by one of the client methods.
+func IsNotFound(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "http code: 404\n")
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -104,6 +105,211 @@ func TestChangeInstanceGetByIdNotFound(t *testing.T) {
 	}
 }
 
+func TestServiceItemGetById(t *testing.T) {
+	mockResponse, err := os.ReadFile("testdata/service_item_200.json")
+	if err != nil {
+		t.Fatalf("Failed to read mock response file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/orcabase/serviceowner/service_items/32/" {
+			t.Errorf("Unexpected request path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(mockResponse)
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	expected := ServiceItem{}
+	if err := json.Unmarshal(mockResponse, &expected); err != nil {
+		t.Fatalf("Failed to unmarshal mock response: %v", err)
+	}
+	if reflect.DeepEqual(expected, ServiceItem{}) {
+		t.Fatalf("Failed to unmarshal mock response: %v", err)
+	}
+
+	result, err := client.ServiceItemGetById(int64(32), "serviceowner")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, result)
+	}
+
+	expectedRelated := []ServiceItemRelated{{Id: 31, Name: "django-app7", ServiceName: "THREE_TIER_APPLICATION"}}
+	if !reflect.DeepEqual(result.Related, expectedRelated) {
+		t.Errorf("Expected related: %v, Got: %v", expectedRelated, result.Related)
+	}
+}
+
+func TestServiceItemGetByIdNotFound(t *testing.T) {
+	mockResponse := []byte(`{"detail":"Not found."}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write(mockResponse)
+		if err != nil {
+			t.Fatalf("Failed to write mock response")
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	_, err := client.ServiceItemGetById(int64(123), "consumer")
+
+	if err == nil {
+		t.Fatalf("Expected error, got nil")
+	}
+
+	expected_err := fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", 404, mockResponse, fmt.Sprintf("%s/v1/orcabase/consumer/service_items/123/", server.URL))
+
+	if err.Error() != expected_err.Error() {
+		t.Fatalf("Expected error message: %s, got %v", expected_err.Error(), err.Error())
+	}
+}
+
+func TestServiceItemPatch(t *testing.T) {
+	mockResponse, err := os.ReadFile("testdata/service_item_200.json")
+	if err != nil {
+		t.Fatalf("Failed to read mock response file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/orcabase/serviceowner/service_items/32/" {
+			t.Errorf("Unexpected request path: %s", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		expectedBody := map[string]interface{}{
+			"deployed_item": map[string]interface{}{"ip": "10.0.0.1"},
+		}
+		if !reflect.DeepEqual(body, expectedBody) {
+			t.Errorf("Expected body: %v, Got: %v", expectedBody, body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(mockResponse)
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	result, err := client.ServiceItemPatch(int64(32), "serviceowner", ServiceItemUpdateRequest{DeployedItem: `{"ip": "10.0.0.1"}`})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Id != 32 {
+		t.Errorf("Expected service item id: %d, Got: %d", 32, result.Id)
+	}
+}
+
+func TestServiceItemPatchDeployedItemRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		// NetOrca returns the deployed_item it was sent wrapped with its version.
+		response, err := json.Marshal(map[string]interface{}{
+			"id":            32,
+			"deployed_item": map[string]interface{}{"data": body["deployed_item"], "version": 2},
+		})
+		if err != nil {
+			t.Fatalf("Failed to marshal mock response: %v", err)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(response)
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	result, err := client.ServiceItemPatch(int64(32), "serviceowner", ServiceItemUpdateRequest{DeployedItem: `{"ip": "10.0.0.1"}`})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]interface{}{"ip": "10.0.0.1"}
+	if !reflect.DeepEqual(result.DeployedItemData(), expected) {
+		t.Errorf("Expected deployed_item data: %v, Got: %v", expected, result.DeployedItemData())
+	}
+	if result.DeployedItemVersion() != 2 {
+		t.Errorf("Expected deployed_item version: %d, Got: %d", 2, result.DeployedItemVersion())
+	}
+}
+
+func TestServiceItemPatchRuntimeState(t *testing.T) {
+	mockResponse, err := os.ReadFile("testdata/service_item_200.json")
+	if err != nil {
+		t.Fatalf("Failed to read mock response file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		expectedBody := map[string]interface{}{
+			"runtime_state": "OUT_OF_SERVICE",
+		}
+		if !reflect.DeepEqual(body, expectedBody) {
+			t.Errorf("Expected body: %v, Got: %v", expectedBody, body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(mockResponse)
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	_, err = client.ServiceItemPatch(int64(32), "serviceowner", ServiceItemUpdateRequest{RuntimeState: "OUT_OF_SERVICE"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestServiceItemPatchInvalidDeployedItem(t *testing.T) {
+	apikey := "123456"
+	url := "http://127.0.0.1"
+	client := NewClient(&url, &apikey, context.Background())
+
+	_, err := client.ServiceItemPatch(int64(32), "serviceowner", ServiceItemUpdateRequest{DeployedItem: `not json`})
+	if err == nil {
+		t.Fatalf("Expected error, got nil")
+	}
+}
+
 func TestServiceItemGetList(t *testing.T) {
 	mockResponse, err := os.ReadFile("testdata/service_items_200.json")
 	if err != nil {
@@ -150,3 +356,180 @@ func TestServiceItemGetList(t *testing.T) {
 		t.Errorf("Expected: %v, Got: %v", expected, result)
 	}
 }
+
+func TestChangeInstanceGetAll(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := NetOrcaChangeInstance{Count: 2, Results: []ChangeInstance{{Id: 1}}}
+		if r.URL.Query().Get("page") == "2" {
+			page.Results = []ChangeInstance{{Id: 2}}
+		} else {
+			if r.URL.Query().Get("service_item_id") != "31" {
+				t.Errorf("Expected service_item_id=31, got query %s", r.URL.RawQuery)
+			}
+			page.Next = fmt.Sprintf("%s/v1/orcabase/serviceowner/change_instances/?page=2", server.URL)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	result, err := client.ChangeInstanceGetAll(&ChangeInstanceQuery{Pov: "serviceowner", ServiceItemId: 31})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result) != 2 || result[0].Id != 1 || result[1].Id != 2 {
+		t.Errorf("Expected change instances 1 and 2 from both pages, got %+v", result)
+	}
+}
+
+func TestChangeInstanceLastDescription(t *testing.T) {
+	tests := []struct {
+		description string
+		log         string
+		expected    string
+	}{
+		{log: "", expected: ""},
+		{log: "Approved by terraform", expected: "Approved by terraform"},
+		{log: "Approved by terraform\nRejected: duplicate request\n\n", expected: "Rejected: duplicate request"},
+		{log: "Approved by terraform\nRejected: duplicate request\nsee ticket 42", expected: "see ticket 42"},
+		{description: "Rejected: duplicate request\nsee ticket 42", log: "Approved by terraform\nRejected: duplicate request\nsee ticket 42", expected: "Rejected: duplicate request\nsee ticket 42"},
+	}
+
+	for _, test := range tests {
+		if result := (ChangeInstance{Description: test.description, Log: test.log}).LastDescription(); result != test.expected {
+			t.Errorf("Expected %q, got %q", test.expected, result)
+		}
+	}
+}
+
+func TestParseChangeInstanceID(t *testing.T) {
+	tests := []struct {
+		id     string
+		pov    string
+		expect int64
+		errMsg string
+	}{
+		{id: "serviceowner/123", pov: PovServiceOwner, expect: 123},
+		{id: "consumer/7", pov: PovConsumer, expect: 7},
+		{id: " 123 ", pov: PovServiceOwner, expect: 123},
+		{id: "", errMsg: `change instance id "" is not a positive integer`},
+		{id: "admin/123", errMsg: `unknown pov "admin", expected one of: serviceowner, consumer`},
+		{id: "serviceowner/abc", errMsg: `change instance id "abc" is not a positive integer`},
+		{id: "serviceowner/0", errMsg: `change instance id "0" is not a positive integer`},
+		{id: "serviceowner/1/2", errMsg: `"serviceowner/1/2" has 3 parts, expected {pov}/{id} or {id}`},
+	}
+
+	for _, test := range tests {
+		pov, id, err := ParseChangeInstanceID(test.id, PovServiceOwner)
+		if test.errMsg != "" {
+			if err == nil || err.Error() != test.errMsg {
+				t.Errorf("%q: expected error %s, got %v", test.id, test.errMsg, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: expected no error, got %v", test.id, err)
+			continue
+		}
+		if pov != test.pov || id != test.expect {
+			t.Errorf("%q: expected %s/%d, got %s/%d", test.id, test.pov, test.expect, pov, id)
+		}
+	}
+}
+
+func TestChangeInstanceDeclarationDiff(t *testing.T) {
+	tests := []struct {
+		name           string
+		changeInstance ChangeInstance
+		expected       string
+	}{
+		{
+			name: "create",
+			changeInstance: ChangeInstance{
+				ChangeType:     "CREATE",
+				NewDeclaration: &ChangeInstanceDeclaration{Version: 1, Declaration: map[string]interface{}{"name": "www"}},
+			},
+			expected: `[{"op":"add","path":"/name","value":"www"}]`,
+		},
+		{
+			name: "modify",
+			changeInstance: ChangeInstance{
+				ChangeType:     "MODIFY",
+				OldDeclaration: &ChangeInstanceDeclaration{Version: 1, Declaration: map[string]interface{}{"name": "www", "size": "small"}},
+				NewDeclaration: &ChangeInstanceDeclaration{Version: 2, Declaration: map[string]interface{}{"name": "www", "size": "large"}},
+			},
+			expected: `[{"op":"replace","path":"/size","value":"large"}]`,
+		},
+		{
+			name: "delete",
+			changeInstance: ChangeInstance{
+				ChangeType:     "DELETE",
+				OldDeclaration: &ChangeInstanceDeclaration{Version: 2, Declaration: map[string]interface{}{"name": "www"}},
+			},
+			expected: `[{"op":"remove","path":"/name"}]`,
+		},
+		{
+			name:           "no_declarations",
+			changeInstance: ChangeInstance{},
+			expected:       `[]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.changeInstance.DeclarationDiff())
+			if err != nil {
+				t.Fatalf("Failed to marshal diff: %v", err)
+			}
+			if string(b) != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, b)
+			}
+		})
+	}
+}
+
+func TestChangeInstancePatchDeployedItem(t *testing.T) {
+	tests := []struct {
+		name         string
+		deployedItem string
+		expected     string
+	}{
+		{name: "set", deployedItem: `{"deployed": true}`, expected: `{"state":"COMPLETED","description":"done","deployed_item":{"deployed":true}}`},
+		{name: "unchanged", deployedItem: "", expected: `{"state":"COMPLETED","description":"done"}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var err error
+				body, err = io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("Failed to read request body: %v", err)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			apikey := "123456"
+			client := NewClient(&server.URL, &apikey, context.Background())
+
+			err := client.ChangeInstancePatch(54, PovServiceOwner, ChangeInstanceUpdateRequest{State: "COMPLETED", Description: "done", DeployedItem: test.deployedItem})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(body) != test.expected {
+				t.Errorf("Expected body %s, got %s", test.expected, body)
+			}
+		})
+	}
+}
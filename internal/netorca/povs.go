@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+const (
+	PovServiceOwner = "serviceowner"
+	PovConsumer     = "consumer"
+)
+
+// Povs lists the points of view requests can be made from.
+var Povs = []string{PovServiceOwner, PovConsumer}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	RuntimeStateInService    = "IN_SERVICE"
+	RuntimeStateOutOfService = "OUT_OF_SERVICE"
+)
+
+// RuntimeStates lists every runtime state a service item can be in.
+var RuntimeStates = []string{
+	RuntimeStateInService,
+	RuntimeStateOutOfService,
+}
+
+// runtimeStateTransitions maps each runtime state to the states a service owner may move a service item to.
+var runtimeStateTransitions = map[string][]string{
+	RuntimeStateInService:    {RuntimeStateOutOfService},
+	RuntimeStateOutOfService: {RuntimeStateInService},
+}
+
+// AllowedRuntimeStateTransitions returns the runtime states a service item can move to from the given state.
+func AllowedRuntimeStateTransitions(from string) []string {
+	return runtimeStateTransitions[from]
+}
+
+// ValidateRuntimeStateTransition returns an error if a service item can't move from one runtime state to another.
+// Staying in the same state is always valid.
+func ValidateRuntimeStateTransition(from, to string) error {
+	if _, ok := runtimeStateTransitions[to]; !ok {
+		return fmt.Errorf("unknown runtime_state %s, expected one of: %s", to, strings.Join(RuntimeStates, ", "))
+	}
+
+	if from == to {
+		return nil
+	}
+
+	allowed, ok := runtimeStateTransitions[from]
+	if !ok {
+		return fmt.Errorf("unknown current runtime_state %s, expected one of: %s", from, strings.Join(RuntimeStates, ", "))
+	}
+
+	for _, state := range allowed {
+		if state == to {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("runtime_state can't move from %s to %s, allowed next states: %s", from, to, strings.Join(allowed, ", "))
+}
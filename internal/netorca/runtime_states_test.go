@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"testing"
+)
+
+func TestValidateRuntimeStateTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		errMsg string
+	}{
+		{
+			name:   "in_service_to_out_of_service",
+			from:   RuntimeStateInService,
+			to:     RuntimeStateOutOfService,
+			errMsg: "",
+		},
+		{
+			name:   "out_of_service_to_in_service",
+			from:   RuntimeStateOutOfService,
+			to:     RuntimeStateInService,
+			errMsg: "",
+		},
+		{
+			name:   "unchanged",
+			from:   RuntimeStateInService,
+			to:     RuntimeStateInService,
+			errMsg: "",
+		},
+		{
+			name:   "unknown_target_state",
+			from:   RuntimeStateInService,
+			to:     "RETIRED",
+			errMsg: "unknown runtime_state RETIRED, expected one of: IN_SERVICE, OUT_OF_SERVICE",
+		},
+		{
+			name:   "unknown_current_state",
+			from:   "RETIRED",
+			to:     RuntimeStateInService,
+			errMsg: "unknown current runtime_state RETIRED, expected one of: IN_SERVICE, OUT_OF_SERVICE",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateRuntimeStateTransition(test.from, test.to)
+
+			if test.errMsg == "" && err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if test.errMsg != "" && (err == nil || err.Error() != test.errMsg) {
+				t.Errorf("Expected error message: %s, Got: %v", test.errMsg, err)
+			}
+		})
+	}
+}
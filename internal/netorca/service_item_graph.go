@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ServiceItemGraphNode is a service item within a dependency graph, along with the ids of the items it depends on.
+type ServiceItemGraphNode struct {
+	Id          int64
+	Name        string
+	ServiceName string
+	DependsOn   []int64
+	// Level is 0 for items without dependencies, otherwise one more than the highest level of its dependencies.
+	// Items sharing a level can be processed in parallel.
+	Level int64
+}
+
+// ServiceItemGraph fetches the given service items and, transitively, every item they depend on through their related field.
+// The nodes are returned topologically sorted so each item comes after the items it depends on.
+func (c *NetOrcaClient) ServiceItemGraph(ids []int64, pov string) ([]ServiceItemGraphNode, error) {
+	items := map[int64]ServiceItem{}
+	pending := append([]int64{}, ids...)
+
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+
+		if _, ok := items[id]; ok {
+			continue
+		}
+
+		serviceItem, err := c.ServiceItemGetById(id, pov)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get service item id: %d: %w", id, err)
+		}
+		items[id] = serviceItem
+
+		for _, related := range serviceItem.Related {
+			if _, ok := items[related.Id]; !ok {
+				pending = append(pending, related.Id)
+			}
+		}
+	}
+
+	return SortServiceItemGraph(items)
+}
+
+// SortServiceItemGraph topologically sorts service items so that each item comes after the items it depends on.
+// Items at the same level are ordered by id so the result is stable. An error is returned if the dependencies form a cycle.
+func SortServiceItemGraph(items map[int64]ServiceItem) ([]ServiceItemGraphNode, error) {
+	dependants := map[int64][]int64{}
+	remaining := map[int64]int{}
+
+	for id, item := range items {
+		remaining[id] = 0
+		for _, related := range item.Related {
+			if _, ok := items[related.Id]; !ok {
+				return nil, fmt.Errorf("service item id: %d depends on service item id: %d which is not part of the graph", id, related.Id)
+			}
+			dependants[related.Id] = append(dependants[related.Id], id)
+			remaining[id]++
+		}
+	}
+
+	levels := map[int64]int64{}
+	ready := []int64{}
+	for id, count := range remaining {
+		if count == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	nodes := make([]ServiceItemGraphNode, 0, len(items))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return ready[i] < ready[j] })
+		next := []int64{}
+
+		for _, id := range ready {
+			item := items[id]
+			dependsOn := []int64{}
+			for _, related := range item.Related {
+				dependsOn = append(dependsOn, related.Id)
+				if levels[related.Id]+1 > levels[id] {
+					levels[id] = levels[related.Id] + 1
+				}
+			}
+			sort.Slice(dependsOn, func(i, j int) bool { return dependsOn[i] < dependsOn[j] })
+
+			nodes = append(nodes, ServiceItemGraphNode{
+				Id:          id,
+				Name:        item.Name,
+				ServiceName: item.Service.Name,
+				DependsOn:   dependsOn,
+				Level:       levels[id],
+			})
+
+			for _, dependant := range dependants[id] {
+				remaining[dependant]--
+				if remaining[dependant] == 0 {
+					next = append(next, dependant)
+				}
+			}
+		}
+
+		ready = next
+	}
+
+	if len(nodes) != len(items) {
+		cycle := []int64{}
+		for id, count := range remaining {
+			if count > 0 {
+				cycle = append(cycle, id)
+			}
+		}
+		sort.Slice(cycle, func(i, j int) bool { return cycle[i] < cycle[j] })
+		return nil, fmt.Errorf("service item dependencies form a cycle between ids: %v", cycle)
+	}
+
+	return nodes, nil
+}
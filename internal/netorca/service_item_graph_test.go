@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func newGraphServiceItem(id int64, related ...int64) ServiceItem {
+	item := ServiceItem{
+		Id:      id,
+		Name:    fmt.Sprintf("item-%d", id),
+		Service: ServiceItemService{Name: "lb"},
+	}
+	for _, r := range related {
+		item.Related = append(item.Related, ServiceItemRelated{Id: r, Name: fmt.Sprintf("item-%d", r), ServiceName: "lb"})
+	}
+	return item
+}
+
+func TestSortServiceItemGraph(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []ServiceItem
+		expected []ServiceItemGraphNode
+		errMsg   string
+	}{
+		{
+			name: "vip_depending_on_pool_members",
+			items: []ServiceItem{
+				newGraphServiceItem(1, 3, 2),
+				newGraphServiceItem(2),
+				newGraphServiceItem(3),
+			},
+			expected: []ServiceItemGraphNode{
+				{Id: 2, Name: "item-2", ServiceName: "lb", DependsOn: []int64{}, Level: 0},
+				{Id: 3, Name: "item-3", ServiceName: "lb", DependsOn: []int64{}, Level: 0},
+				{Id: 1, Name: "item-1", ServiceName: "lb", DependsOn: []int64{2, 3}, Level: 1},
+			},
+		},
+		{
+			name: "chain",
+			items: []ServiceItem{
+				newGraphServiceItem(1),
+				newGraphServiceItem(2, 3),
+				newGraphServiceItem(3, 1),
+			},
+			expected: []ServiceItemGraphNode{
+				{Id: 1, Name: "item-1", ServiceName: "lb", DependsOn: []int64{}, Level: 0},
+				{Id: 3, Name: "item-3", ServiceName: "lb", DependsOn: []int64{1}, Level: 1},
+				{Id: 2, Name: "item-2", ServiceName: "lb", DependsOn: []int64{3}, Level: 2},
+			},
+		},
+		{
+			name: "cycle",
+			items: []ServiceItem{
+				newGraphServiceItem(1, 2),
+				newGraphServiceItem(2, 1),
+				newGraphServiceItem(3),
+			},
+			errMsg: "service item dependencies form a cycle between ids: [1 2]",
+		},
+		{
+			name: "missing_dependency",
+			items: []ServiceItem{
+				newGraphServiceItem(1, 2),
+			},
+			errMsg: "service item id: 1 depends on service item id: 2 which is not part of the graph",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items := map[int64]ServiceItem{}
+			for _, item := range test.items {
+				items[item.Id] = item
+			}
+
+			result, err := SortServiceItemGraph(items)
+
+			if test.errMsg != "" {
+				if err == nil || err.Error() != test.errMsg {
+					t.Fatalf("Expected error message: %s, Got: %v", test.errMsg, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Expected: %v, Got: %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceItemGraph(t *testing.T) {
+	items := map[string]ServiceItem{
+		"/v1/orcabase/serviceowner/service_items/1/": newGraphServiceItem(1, 2),
+		"/v1/orcabase/serviceowner/service_items/2/": newGraphServiceItem(2),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		item, ok := items[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(item); err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	result, err := client.ServiceItemGraph([]int64{1}, "serviceowner")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []ServiceItemGraphNode{
+		{Id: 2, Name: "item-2", ServiceName: "lb", DependsOn: []int64{}, Level: 0},
+		{Id: 1, Name: "item-1", ServiceName: "lb", DependsOn: []int64{2}, Level: 1},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, result)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"sort"
+
+	"terraform-provider-netorca/internal/jsonpatch"
+)
+
+// ServiceItemHistoryVersion is a single version of a service item's declaration, as submitted through a change instance.
+type ServiceItemHistoryVersion struct {
+	ChangeInstanceId int64
+	Version          int64
+	SubmissionId     int64
+	CommitId         string
+	ChangeType       string
+	State            string
+	Created          string
+	Declaration      map[string]interface{}
+	// Diff is the JSON Patch from the previous version's declaration to this one, skipping REJECTED and ERROR versions.
+	Diff []jsonpatch.Operation
+}
+
+// ServiceItemHistory returns every declaration version of a service item, oldest first.
+func (c *NetOrcaClient) ServiceItemHistory(id int64, pov string) ([]ServiceItemHistoryVersion, error) {
+	changeInstances, err := c.ChangeInstanceGetAll(&ChangeInstanceQuery{Pov: pov, ServiceItemId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildServiceItemHistory(changeInstances), nil
+}
+
+// BuildServiceItemHistory orders the change instances of a service item by creation time and diffs each declaration
+// against the one before it. REJECTED and ERROR versions were never applied, so they aren't diffed against, each diff
+// starts from the last version that wasn't rejected or failed. The first version is diffed against an empty declaration.
+func BuildServiceItemHistory(changeInstances []ChangeInstance) []ServiceItemHistoryVersion {
+	sorted := make([]ChangeInstance, len(changeInstances))
+	copy(sorted, changeInstances)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Created != sorted[j].Created {
+			return sorted[i].Created < sorted[j].Created
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	history := make([]ServiceItemHistoryVersion, 0, len(sorted))
+	var previous map[string]interface{}
+
+	for _, v := range sorted {
+		version := ServiceItemHistoryVersion{
+			ChangeInstanceId: v.Id,
+			SubmissionId:     v.Submission.Id,
+			CommitId:         v.Submission.CommitId,
+			ChangeType:       v.ChangeType,
+			State:            v.State,
+			Created:          v.Created,
+		}
+		if v.NewDeclaration != nil {
+			version.Version = v.NewDeclaration.Version
+			version.Declaration = v.NewDeclaration.Declaration
+		}
+		version.Diff = jsonpatch.Diff(previous, version.Declaration)
+		if version.Diff == nil {
+			version.Diff = []jsonpatch.Operation{}
+		}
+
+		history = append(history, version)
+		if v.State != ChangeInstanceStateRejected && v.State != ChangeInstanceStateError {
+			previous = version.Declaration
+		}
+	}
+
+	return history
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildServiceItemHistory(t *testing.T) {
+	changeInstances := []ChangeInstance{
+		{
+			Id:         12,
+			State:      "PENDING",
+			Created:    "2025-03-02T09:00:00.000000Z",
+			ChangeType: "MODIFY",
+			Submission: ChangeInstanceSubmission{Id: 40, CommitId: "b2"},
+			NewDeclaration: &ChangeInstanceDeclaration{
+				Version:     2,
+				Declaration: map[string]interface{}{"name": "django-app7", "size": "large"},
+			},
+		},
+		{
+			Id:         14,
+			State:      "REJECTED",
+			Created:    "2025-03-02T12:00:00.000000Z",
+			ChangeType: "MODIFY",
+			Submission: ChangeInstanceSubmission{Id: 42, CommitId: "b3"},
+			NewDeclaration: &ChangeInstanceDeclaration{
+				Version:     3,
+				Declaration: map[string]interface{}{"name": "django-app7", "size": "huge"},
+			},
+		},
+		{
+			Id:         15,
+			State:      "PENDING",
+			Created:    "2025-03-02T15:00:00.000000Z",
+			ChangeType: "MODIFY",
+			Submission: ChangeInstanceSubmission{Id: 43, CommitId: "b4"},
+			NewDeclaration: &ChangeInstanceDeclaration{
+				Version:     4,
+				Declaration: map[string]interface{}{"name": "django-app7", "size": "large", "zone": "eu"},
+			},
+		},
+		{
+			Id:         13,
+			State:      "PENDING",
+			Created:    "2025-03-03T09:00:00.000000Z",
+			ChangeType: "DELETE",
+			Submission: ChangeInstanceSubmission{Id: 41, CommitId: "c3"},
+		},
+		{
+			Id:         11,
+			State:      "COMPLETED",
+			Created:    "2025-02-28T13:18:31.651446Z",
+			ChangeType: "CREATE",
+			Submission: ChangeInstanceSubmission{Id: 31, CommitId: "a1"},
+			NewDeclaration: &ChangeInstanceDeclaration{
+				Version:     1,
+				Declaration: map[string]interface{}{"name": "django-app7", "size": "small"},
+			},
+		},
+	}
+
+	history := BuildServiceItemHistory(changeInstances)
+
+	expected := []struct {
+		changeInstanceId int64
+		version          int64
+		commitId         string
+		diff             string
+	}{
+		{11, 1, "a1", `[{"op":"add","path":"/name","value":"django-app7"},{"op":"add","path":"/size","value":"small"}]`},
+		{12, 2, "b2", `[{"op":"replace","path":"/size","value":"large"}]`},
+		{14, 3, "b3", `[{"op":"replace","path":"/size","value":"huge"}]`},
+		// The rejected version 3 was never applied, so version 4 is diffed against version 2.
+		{15, 4, "b4", `[{"op":"add","path":"/zone","value":"eu"}]`},
+		{13, 0, "c3", `[{"op":"remove","path":"/name"},{"op":"remove","path":"/size"},{"op":"remove","path":"/zone"}]`},
+	}
+
+	if len(history) != len(expected) {
+		t.Fatalf("Expected %d versions, got %d", len(expected), len(history))
+	}
+
+	for i, e := range expected {
+		v := history[i]
+		if v.ChangeInstanceId != e.changeInstanceId || v.Version != e.version || v.CommitId != e.commitId {
+			t.Errorf("Version %d: expected change instance %d version %d commit %s, got %d %d %s",
+				i, e.changeInstanceId, e.version, e.commitId, v.ChangeInstanceId, v.Version, v.CommitId)
+		}
+
+		diff, err := json.Marshal(v.Diff)
+		if err != nil {
+			t.Fatalf("Failed to marshal diff: %v", err)
+		}
+		if string(diff) != e.diff {
+			t.Errorf("Version %d: expected diff %s, got %s", i, e.diff, diff)
+		}
+	}
+}
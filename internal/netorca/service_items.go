@@ -3,6 +3,7 @@
 package netorca
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,10 +26,55 @@ type ServiceItem struct {
 	ConsumerTeam      ServiceItemConsumerTeam     `json:"consumer_team"`
 	ServiceOwnerTeam  ServiceItemServiceOwnerTeam `json:"service_owner_team"`
 	Declaration       map[string]interface{}      `json:"declaration"`
-	Related           interface{}                 `json:"related"`
+	Related           []ServiceItemRelated        `json:"related"`
 	HealthcheckStatus *int64                      `json:"healthcheck_status"`
 }
 
+// DeployedItemData returns the deployed_item as written by the service owner. NetOrca returns it wrapped as
+// {"data": ..., "version": n}, only the data is returned.
+func (s ServiceItem) DeployedItemData() map[string]interface{} {
+	data, _, ok := s.unwrapDeployedItem()
+	if !ok {
+		return s.DeployedItem
+	}
+
+	return data
+}
+
+// DeployedItemVersion returns the version NetOrca recorded for the deployed_item, 0 when there is none.
+func (s ServiceItem) DeployedItemVersion() int64 {
+	_, version, _ := s.unwrapDeployedItem()
+	return version
+}
+
+// unwrapDeployedItem splits the deployed_item into its data and version, ok is false when it isn't wrapped.
+func (s ServiceItem) unwrapDeployedItem() (map[string]interface{}, int64, bool) {
+	data, hasData := s.DeployedItem["data"]
+	version, hasVersion := s.DeployedItem["version"].(float64)
+	if !hasData || !hasVersion || len(s.DeployedItem) != 2 {
+		return nil, 0, false
+	}
+
+	// A deployed_item that was never written is returned with null data.
+	if data == nil {
+		return nil, int64(version), true
+	}
+
+	dataObject, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, 0, false
+	}
+
+	return dataObject, int64(version), true
+}
+
+// ServiceItemRelated is a reference to another service item that a service item depends on.
+type ServiceItemRelated struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	ServiceName string `json:"service_name"`
+}
+
 type ServiceItemServiceOwnerTeam struct {
 	Id   int64  `json:"id"`
 	Name string `json:"name"`
@@ -75,6 +121,17 @@ type NetOrcaApplication struct {
 	Owner    int64       `json:"owner"`
 }
 
+// ServiceItemUpdateRequest holds the service item fields to update, empty fields are left unchanged.
+type ServiceItemUpdateRequest struct {
+	DeployedItem string `json:"deployed_item"`
+	RuntimeState string `json:"runtime_state"`
+}
+
+type ServiceItemUpdateJson struct {
+	DeployedItem *map[string]interface{} `json:"deployed_item,omitempty"`
+	RuntimeState string                  `json:"runtime_state,omitempty"`
+}
+
 type NetOrcaServiceItem struct {
 	Count    int
 	Next     string
@@ -91,6 +148,29 @@ func (c *NetOrcaClient) ServiceItemsGet(s *ServiceItemQuery) (NetOrcaServiceItem
 		url = fmt.Sprintf("%s%s", url, queryParameters)
 	}
 
+	return c.serviceItemsGetPage(url)
+}
+
+// ServiceItemsGetAll returns the service items matching the query from every page of results.
+func (c *NetOrcaClient) ServiceItemsGetAll(s *ServiceItemQuery) ([]ServiceItem, error) {
+	serviceItems, err := c.ServiceItemsGet(s)
+	if err != nil {
+		return nil, err
+	}
+
+	results := serviceItems.Results
+	for next := serviceItems.Next; next != ""; next = serviceItems.Next {
+		serviceItems, err = c.serviceItemsGetPage(next)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, serviceItems.Results...)
+	}
+
+	return results, nil
+}
+
+func (c *NetOrcaClient) serviceItemsGetPage(url string) (NetOrcaServiceItem, error) {
 	serv, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return NetOrcaServiceItem{}, err
@@ -125,6 +205,98 @@ func (c *NetOrcaClient) ServiceItemsGet(s *ServiceItemQuery) (NetOrcaServiceItem
 	return serviceItems, nil
 }
 
+func (c *NetOrcaClient) ServiceItemPatch(id int64, pov string, request ServiceItemUpdateRequest) (ServiceItem, error) {
+	url := fmt.Sprintf("%s/v1/orcabase/%s/service_items/%d/", c.baseUrl, pov, id)
+	content := ServiceItemUpdateJson{
+		RuntimeState: request.RuntimeState,
+	}
+
+	if request.DeployedItem != "" {
+		var deployedItem map[string]interface{}
+
+		err := json.Unmarshal([]byte(request.DeployedItem), &deployedItem)
+		if err != nil {
+			return ServiceItem{}, err
+		}
+		content.DeployedItem = &deployedItem
+	}
+
+	body, err := json.Marshal(content)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	serv, err := http.NewRequest("PATCH", url, bytes.NewBuffer(body))
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+	serv.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return ServiceItem{}, fmt.Errorf("http code: %d\nresponse: %s\nurl: %s\nmethod: PATCH", resp.StatusCode, b, url)
+	}
+
+	var serviceItem ServiceItem
+
+	err = json.Unmarshal(b, &serviceItem)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	return serviceItem, nil
+}
+
+func (c *NetOrcaClient) ServiceItemGetById(id int64, pov string) (ServiceItem, error) {
+
+	url := fmt.Sprintf("%s/v1/orcabase/%s/service_items/%d/", c.baseUrl, pov, id)
+
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return ServiceItem{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var serviceItem ServiceItem
+
+	err = json.Unmarshal(b, &serviceItem)
+	if err != nil {
+		return ServiceItem{}, err
+	}
+
+	return serviceItem, nil
+}
+
 // Returns a *ServiceItemQuery or nil and an error message if one of the type inferences aren't handled.
 func NewServiceItemQuery(args map[string]interface{}) (*ServiceItemQuery, error) {
 	s := ServiceItemQuery{}
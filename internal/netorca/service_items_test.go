@@ -579,3 +579,49 @@ func TestGetQueryParam(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceItemDeployedItemData(t *testing.T) {
+	tests := []struct {
+		name            string
+		deployedItem    map[string]interface{}
+		expectedData    map[string]interface{}
+		expectedVersion int64
+	}{
+		{
+			name:            "wrapped",
+			deployedItem:    map[string]interface{}{"data": map[string]interface{}{"ip": "10.0.0.1"}, "version": float64(3)},
+			expectedData:    map[string]interface{}{"ip": "10.0.0.1"},
+			expectedVersion: 3,
+		},
+		{
+			name:            "wrapped_null_data",
+			deployedItem:    map[string]interface{}{"data": nil, "version": float64(0)},
+			expectedData:    nil,
+			expectedVersion: 0,
+		},
+		{
+			name:            "unwrapped",
+			deployedItem:    map[string]interface{}{"data": "netorca", "ip": "10.0.0.1"},
+			expectedData:    map[string]interface{}{"data": "netorca", "ip": "10.0.0.1"},
+			expectedVersion: 0,
+		},
+		{
+			name:            "null",
+			deployedItem:    nil,
+			expectedData:    nil,
+			expectedVersion: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serviceItem := ServiceItem{DeployedItem: test.deployedItem}
+			if !reflect.DeepEqual(serviceItem.DeployedItemData(), test.expectedData) {
+				t.Errorf("Expected data %v, got %v", test.expectedData, serviceItem.DeployedItemData())
+			}
+			if serviceItem.DeployedItemVersion() != test.expectedVersion {
+				t.Errorf("Expected version %d, got %d", test.expectedVersion, serviceItem.DeployedItemVersion())
+			}
+		})
+	}
+}
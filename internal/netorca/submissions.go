@@ -0,0 +1,571 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Submission is a consumer declaration of applications keyed by application name, as sent to NetOrca.
+type Submission map[string]SubmissionApplication
+
+// SubmissionApplication holds an application's metadata and its service items' declarations keyed by service name.
+type SubmissionApplication struct {
+	Metadata interface{}                         `json:"metadata"`
+	Services map[string][]map[string]interface{} `json:"services"`
+}
+
+type SubmissionResponse struct {
+	Id       int64  `json:"id"`
+	CommitId string `json:"commit_id"`
+}
+
+// SubmissionRecord is a submission as listed by NetOrca.
+type SubmissionRecord struct {
+	Id           int64                  `json:"id"`
+	CommitId     string                 `json:"commit_id"`
+	Status       string                 `json:"status"`
+	Created      string                 `json:"created"`
+	ConsumerTeam SubmissionConsumerTeam `json:"consumer_team"`
+}
+
+type SubmissionConsumerTeam struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type NetOrcaSubmission struct {
+	Count    int
+	Next     string
+	Previous string
+	Results  []SubmissionRecord
+}
+
+// SubmissionQuery filters the submissions listed. CreatedAfter and CreatedBefore are RFC 3339 timestamps.
+type SubmissionQuery struct {
+	Pov            string
+	ApplicationId  int64
+	ConsumerTeamId int64
+	CommitId       string
+	CreatedAfter   string
+	CreatedBefore  string
+	Ordering       string
+	Limit          int64
+}
+
+// ParseSubmission decodes a json declaration of applications keyed by name into a submission.
+func ParseSubmission(declaration string) (Submission, error) {
+	var submission Submission
+	if err := json.Unmarshal([]byte(declaration), &submission); err != nil {
+		return nil, fmt.Errorf("declaration must be a json object of applications keyed by name: %s", err.Error())
+	}
+
+	for name, application := range submission {
+		if application.Services == nil {
+			return nil, fmt.Errorf("application %s has no services, set services to {} to declare no service items", name)
+		}
+	}
+
+	return submission, nil
+}
+
+// SubmissionError is a validation error NetOrca reported for part of a submission. Path locates the invalid value, e.g.
+// [app7 services a_record 0 zone], and is empty for errors about the submission as a whole.
+type SubmissionError struct {
+	Path    []string
+	Message string
+}
+
+// SubmissionValidationError is returned when NetOrca rejects a submission as invalid.
+type SubmissionValidationError struct {
+	StatusCode int
+	Body       []byte
+	Url        string
+	Errors     []SubmissionError
+}
+
+func (e *SubmissionValidationError) Error() string {
+	return fmt.Sprintf("http code: %d\nresponse: %s\nurl: %s\nmethod: POST", e.StatusCode, e.Body, e.Url)
+}
+
+// ParseSubmissionErrors flattens a NetOrca validation error response into one error per message. Objects and arrays are
+// walked down to their messages, the keys and indexes on the way make up the path. A top level errors key is unwrapped.
+func ParseSubmissionErrors(body []byte) []SubmissionError {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return []SubmissionError{{Path: []string{}, Message: string(body)}}
+	}
+
+	if m, ok := doc.(map[string]interface{}); ok {
+		if nested, ok := m["errors"]; ok {
+			doc = nested
+		}
+	}
+
+	submissionErrors := collectSubmissionErrors(doc, []string{}, nil)
+	if len(submissionErrors) == 0 {
+		return []SubmissionError{{Path: []string{}, Message: string(body)}}
+	}
+
+	return submissionErrors
+}
+
+func collectSubmissionErrors(v interface{}, path []string, submissionErrors []SubmissionError) []SubmissionError {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			submissionErrors = collectSubmissionErrors(t[k], append(path[:len(path):len(path)], k), submissionErrors)
+		}
+	case []interface{}:
+		// A list of messages belongs to the path itself, other lists are indexed.
+		for i, e := range t {
+			if _, ok := e.(string); ok {
+				submissionErrors = collectSubmissionErrors(e, path, submissionErrors)
+				continue
+			}
+			submissionErrors = collectSubmissionErrors(e, append(path[:len(path):len(path)], strconv.Itoa(i)), submissionErrors)
+		}
+	case string:
+		submissionErrors = append(submissionErrors, SubmissionError{Path: path, Message: t})
+	}
+
+	return submissionErrors
+}
+
+// SubmissionValidation is NetOrca's verdict on a submission that was validated without being submitted.
+type SubmissionValidation struct {
+	Valid           bool
+	Errors          []SubmissionError
+	ChangeInstances []SubmissionValidationChange
+}
+
+// SubmissionValidationChange is a change instance a validated submission would create.
+type SubmissionValidationChange struct {
+	ApplicationName string `json:"application"`
+	ServiceName     string `json:"service"`
+	ServiceItemName string `json:"service_item"`
+	ChangeType      string `json:"change_type"`
+}
+
+type submissionValidationResponse struct {
+	IsValid         *bool                        `json:"is_valid"`
+	Errors          json.RawMessage              `json:"errors"`
+	ChangeInstances []SubmissionValidationChange `json:"change_instances"`
+}
+
+// SubmissionSubmit sends the submission from the consumer POV. A partial submission only changes the applications it
+// contains, the rest of the team's applications are left as they are.
+func (c *NetOrcaClient) SubmissionSubmit(submission Submission, commitId string, partial bool) (SubmissionResponse, error) {
+	statusCode, b, submitUrl, err := c.submissionPost("submit", submission, commitId, partial)
+	if err != nil {
+		return SubmissionResponse{}, err
+	}
+
+	if statusCode == 400 || statusCode == 422 {
+		return SubmissionResponse{}, &SubmissionValidationError{StatusCode: statusCode, Body: b, Url: submitUrl, Errors: ParseSubmissionErrors(b)}
+	}
+
+	if statusCode != 200 && statusCode != 201 {
+		return SubmissionResponse{}, fmt.Errorf("http code: %d\nresponse: %s\nurl: %s\nmethod: POST", statusCode, b, submitUrl)
+	}
+
+	var submissionResponse SubmissionResponse
+
+	err = json.Unmarshal(b, &submissionResponse)
+	if err != nil {
+		return SubmissionResponse{}, err
+	}
+
+	return submissionResponse, nil
+}
+
+// SubmissionValidate asks NetOrca whether it would accept the submission from the consumer POV, without submitting it.
+// An invalid submission isn't an error, it is reported in the returned validation.
+func (c *NetOrcaClient) SubmissionValidate(submission Submission, partial bool) (SubmissionValidation, error) {
+	statusCode, b, validateUrl, err := c.submissionPost("validate", submission, "", partial)
+	if err != nil {
+		return SubmissionValidation{}, err
+	}
+
+	if statusCode == 400 || statusCode == 422 {
+		return SubmissionValidation{Valid: false, Errors: ParseSubmissionErrors(b), ChangeInstances: []SubmissionValidationChange{}}, nil
+	}
+
+	if statusCode != 200 {
+		return SubmissionValidation{}, fmt.Errorf("http code: %d\nresponse: %s\nurl: %s\nmethod: POST", statusCode, b, validateUrl)
+	}
+
+	var validationResponse submissionValidationResponse
+
+	err = json.Unmarshal(b, &validationResponse)
+	if err != nil {
+		return SubmissionValidation{}, err
+	}
+
+	validation := SubmissionValidation{
+		Valid:           validationResponse.IsValid == nil || *validationResponse.IsValid,
+		Errors:          []SubmissionError{},
+		ChangeInstances: validationResponse.ChangeInstances,
+	}
+	if !validation.Valid && len(validationResponse.Errors) > 0 {
+		validation.Errors = ParseSubmissionErrors(validationResponse.Errors)
+	}
+	if validation.ChangeInstances == nil {
+		validation.ChangeInstances = []SubmissionValidationChange{}
+	}
+
+	return validation, nil
+}
+
+// submissionPost posts the submission to the submit or validate endpoint, returning the response status, body and url.
+func (c *NetOrcaClient) submissionPost(action string, submission Submission, commitId string, partial bool) (int, []byte, string, error) {
+	postUrl := fmt.Sprintf("%s/v1/orcabase/consumer/submissions/%s/", c.baseUrl, action)
+
+	query := url.Values{}
+	if commitId != "" {
+		query.Set("commit_id", commitId)
+	}
+	if partial {
+		query.Set("partial", "true")
+	}
+	if len(query) > 0 {
+		postUrl = fmt.Sprintf("%s?%s", postUrl, query.Encode())
+	}
+
+	body, err := json.Marshal(submission)
+	if err != nil {
+		return 0, nil, postUrl, err
+	}
+
+	serv, err := http.NewRequest("POST", postUrl, bytes.NewBuffer(body))
+	if err != nil {
+		return 0, nil, postUrl, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+	serv.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return 0, nil, postUrl, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, postUrl, err
+	}
+
+	return resp.StatusCode, b, postUrl, nil
+}
+
+// SubmissionsGet returns the submissions matching the query from every page of results.
+func (c *NetOrcaClient) SubmissionsGet(q *SubmissionQuery) ([]SubmissionRecord, error) {
+	url := fmt.Sprintf("%s/v1/orcabase/%s/submissions/%s", c.baseUrl, q.Pov, q.GetQueryParam())
+
+	var results []SubmissionRecord
+	for url != "" {
+		submissions, err := c.submissionsGetPage(url)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, submissions.Results...)
+		url = submissions.Next
+	}
+
+	return results, nil
+}
+
+func (c *NetOrcaClient) submissionsGetPage(url string) (NetOrcaSubmission, error) {
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return NetOrcaSubmission{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return NetOrcaSubmission{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return NetOrcaSubmission{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return NetOrcaSubmission{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var submissions NetOrcaSubmission
+
+	err = json.Unmarshal(b, &submissions)
+	if err != nil {
+		return NetOrcaSubmission{}, err
+	}
+
+	return submissions, nil
+}
+
+// Returns the formatted query parmaters for use with the http client.
+// e.g. in the form of ?<field_name>=<field_value>&<field_name>=<field_value>
+func (q SubmissionQuery) GetQueryParam() string {
+	queryParam := "?"
+
+	if q.ApplicationId != 0 {
+		queryParam = fmt.Sprintf("%sapplication_id=%d&", queryParam, q.ApplicationId)
+	}
+
+	if q.ConsumerTeamId != 0 {
+		queryParam = fmt.Sprintf("%sconsumer_team_id=%d&", queryParam, q.ConsumerTeamId)
+	}
+
+	if q.CommitId != "" {
+		queryParam = fmt.Sprintf("%scommit_id=%s&", queryParam, url.QueryEscape(q.CommitId))
+	}
+
+	// Timestamps are escaped since a timezone offset may contain a '+'.
+	if q.CreatedAfter != "" {
+		queryParam = fmt.Sprintf("%screated_after=%s&", queryParam, url.QueryEscape(q.CreatedAfter))
+	}
+
+	if q.CreatedBefore != "" {
+		queryParam = fmt.Sprintf("%screated_before=%s&", queryParam, url.QueryEscape(q.CreatedBefore))
+	}
+
+	if q.Ordering != "" {
+		queryParam = fmt.Sprintf("%sordering=%s&", queryParam, url.QueryEscape(q.Ordering))
+	}
+
+	if q.Limit != 0 {
+		queryParam = fmt.Sprintf("%slimit=%d&", queryParam, q.Limit)
+	}
+
+	// Remove the trailing '&' if it exists
+	if queryParam[len(queryParam)-1] == '&' {
+		queryParam = queryParam[:len(queryParam)-1]
+	}
+
+	// If only '?' remains, return an empty string
+	if queryParam == "?" {
+		return ""
+	}
+
+	return queryParam
+}
+
+// LockApplication blocks until no other caller holds the lock of the application and returns the function releasing
+// it. Changes that read an application's submission, edit it and submit it back hold the lock throughout, so that
+// concurrent changes to the same application don't submit stale snapshots over each other.
+func (c *NetOrcaClient) LockApplication(applicationId int64) func() {
+	v, _ := c.applicationLocks.LoadOrStore(applicationId, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// inFlightChangeInstanceStates are the states of change instances that were submitted but aren't applied yet.
+var inFlightChangeInstanceStates = []string{ChangeInstanceStatePending, ChangeInstanceStateApproved}
+
+// ApplicationLatestSubmissionId returns the id of the latest submission made for an application, 0 when there is none.
+func (c *NetOrcaClient) ApplicationLatestSubmissionId(applicationId int64) (int64, error) {
+	q := SubmissionQuery{Pov: PovConsumer, ApplicationId: applicationId, Ordering: "-id", Limit: 1}
+	url := fmt.Sprintf("%s/v1/orcabase/%s/submissions/%s", c.baseUrl, q.Pov, q.GetQueryParam())
+
+	submissions, err := c.submissionsGetPage(url)
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, v := range submissions.Results {
+		if v.Id > latest {
+			latest = v.Id
+		}
+	}
+
+	return latest, nil
+}
+
+// ApplicationSubmission builds a submission holding the declarations of every service item of an application as they
+// will be once its in-flight change instances are applied. Pending deletions are left out and pending creations and
+// modifications use their new declaration, so submitting the application back doesn't cancel or revert them.
+func (c *NetOrcaClient) ApplicationSubmission(applicationId int64) (Submission, string, error) {
+	application, err := c.ApplicationGetById(applicationId, PovConsumer)
+	if err != nil {
+		return nil, "", err
+	}
+
+	serviceItems, err := c.ServiceItemsGetAll(&ServiceItemQuery{Pov: PovConsumer, ApplicationId: applicationId})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var inFlight []ChangeInstance
+	for _, state := range inFlightChangeInstanceStates {
+		changeInstances, err := c.ChangeInstanceGetAll(&ChangeInstanceQuery{Pov: PovConsumer, ApplicationId: applicationId, State: state})
+		if err != nil {
+			return nil, "", err
+		}
+		inFlight = append(inFlight, changeInstances...)
+	}
+
+	submissionApplication := SubmissionApplication{
+		Metadata: application.Metadata,
+		Services: map[string][]map[string]interface{}{},
+	}
+
+	// Items are sorted so that the same application always produces the same submission.
+	sort.Slice(serviceItems, func(i, j int) bool { return serviceItems[i].Id < serviceItems[j].Id })
+	for _, v := range serviceItems {
+		serviceName := v.ServiceName
+		if serviceName == "" {
+			serviceName = v.Service.Name
+		}
+		submissionApplication.Services[serviceName] = append(submissionApplication.Services[serviceName], v.Declaration)
+	}
+
+	submission := Submission{application.Name: submissionApplication}
+	submission.applyChangeInstances(application.Name, inFlight)
+
+	return submission, application.Name, nil
+}
+
+// applyChangeInstances applies the changes of change instances to the service items of an application, oldest first.
+func (s Submission) applyChangeInstances(applicationName string, changeInstances []ChangeInstance) {
+	sorted := make([]ChangeInstance, len(changeInstances))
+	copy(sorted, changeInstances)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Created != sorted[j].Created {
+			return sorted[i].Created < sorted[j].Created
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	for _, v := range sorted {
+		serviceName := v.ServiceItemField.ServiceName
+		if serviceName == "" {
+			serviceName = v.ServiceItemField.Service.Name
+		}
+
+		switch v.ChangeType {
+		case ChangeTypeDelete:
+			s.RemoveServiceItem(applicationName, serviceName, v.ServiceItemField.Name)
+		case ChangeTypeCreate, ChangeTypeModify:
+			if v.NewDeclaration != nil && v.NewDeclaration.Declaration != nil {
+				s.SetServiceItem(applicationName, serviceName, v.ServiceItemField.Name, v.NewDeclaration.Declaration)
+			}
+		}
+	}
+}
+
+// SetServiceItem adds the declaration of a service item to the application, replacing any declaration with the same name.
+func (s Submission) SetServiceItem(applicationName, serviceName, name string, declaration map[string]interface{}) {
+	application := s[applicationName]
+	if application.Services == nil {
+		application.Services = map[string][]map[string]interface{}{}
+	}
+
+	items := application.Services[serviceName]
+	for i, v := range items {
+		if v["name"] == name {
+			items[i] = declaration
+			s[applicationName] = application
+			return
+		}
+	}
+
+	application.Services[serviceName] = append(items, declaration)
+	s[applicationName] = application
+}
+
+// RemoveServiceItem removes the declaration of a service item from the application, the service is kept even when empty.
+func (s Submission) RemoveServiceItem(applicationName, serviceName, name string) {
+	application, ok := s[applicationName]
+	if !ok {
+		return
+	}
+
+	items := []map[string]interface{}{}
+	for _, v := range application.Services[serviceName] {
+		if v["name"] != name {
+			items = append(items, v)
+		}
+	}
+
+	application.Services[serviceName] = items
+	s[applicationName] = application
+}
+
+// SubmissionChangeInstance returns the change instance a submission created for a service item, false when the
+// submission didn't change the service item.
+func (c *NetOrcaClient) SubmissionChangeInstance(submissionId int64, serviceName, name string) (ChangeInstance, bool, error) {
+	changeInstances, err := c.ChangeInstanceGetAll(&ChangeInstanceQuery{Pov: PovConsumer, SubmissionId: submissionId, ServiceName: serviceName})
+	if err != nil {
+		return ChangeInstance{}, false, err
+	}
+
+	for _, v := range changeInstances {
+		if v.ServiceItemField.Name == name {
+			return v, true, nil
+		}
+	}
+
+	return ChangeInstance{}, false, nil
+}
+
+// ErrorServiceItem locates the service item a validation error is about, returning empty names for the parts of the
+// path that don't lead to an application, service or service item. Service items may be addressed by index or by name.
+func (s Submission) ErrorServiceItem(e SubmissionError) (string, string, string) {
+	var applicationName, serviceName, name string
+
+	if len(e.Path) < 1 {
+		return "", "", ""
+	}
+	application, ok := s[e.Path[0]]
+	if !ok {
+		return "", "", ""
+	}
+	applicationName = e.Path[0]
+
+	if len(e.Path) < 3 || e.Path[1] != "services" {
+		return applicationName, "", ""
+	}
+	items, ok := application.Services[e.Path[2]]
+	if !ok {
+		return applicationName, "", ""
+	}
+	serviceName = e.Path[2]
+
+	if len(e.Path) < 4 {
+		return applicationName, serviceName, ""
+	}
+	if i, err := strconv.Atoi(e.Path[3]); err == nil && i >= 0 && i < len(items) {
+		name, _ = items[i]["name"].(string)
+		return applicationName, serviceName, name
+	}
+	for _, v := range items {
+		if v["name"] == e.Path[3] {
+			name = e.Path[3]
+		}
+	}
+
+	return applicationName, serviceName, name
+}
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubmissionSetAndRemoveServiceItem(t *testing.T) {
+	submission := Submission{
+		"app7": {
+			Metadata: map[string]interface{}{"owner": "alpha"},
+			Services: map[string][]map[string]interface{}{
+				"a_record": {
+					{"name": "www", "zone": "example.com"},
+					{"name": "api", "zone": "example.com"},
+				},
+			},
+		},
+	}
+
+	submission.SetServiceItem("app7", "a_record", "www", map[string]interface{}{"name": "www", "zone": "example.org"})
+	submission.SetServiceItem("app7", "cname", "docs", map[string]interface{}{"name": "docs"})
+	submission.RemoveServiceItem("app7", "a_record", "api")
+
+	expected := Submission{
+		"app7": {
+			Metadata: map[string]interface{}{"owner": "alpha"},
+			Services: map[string][]map[string]interface{}{
+				"a_record": {
+					{"name": "www", "zone": "example.org"},
+				},
+				"cname": {
+					{"name": "docs"},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(submission, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, submission)
+	}
+}
+
+func TestSubmissionApplyChangeInstances(t *testing.T) {
+	submission := Submission{
+		"app7": {
+			Services: map[string][]map[string]interface{}{
+				"a_record": {
+					{"name": "www", "zone": "example.com"},
+					{"name": "api", "zone": "example.com"},
+					{"name": "old", "zone": "example.com"},
+				},
+			},
+		},
+	}
+
+	submission.applyChangeInstances("app7", []ChangeInstance{
+		{
+			Id:               22,
+			Created:          "2025-03-02T09:00:00Z",
+			ChangeType:       ChangeTypeModify,
+			ServiceItemField: ServiceItem{Name: "www", ServiceName: "a_record"},
+			NewDeclaration:   &ChangeInstanceDeclaration{Declaration: map[string]interface{}{"name": "www", "zone": "example.net"}},
+		},
+		{
+			Id:               21,
+			Created:          "2025-03-01T09:00:00Z",
+			ChangeType:       ChangeTypeModify,
+			ServiceItemField: ServiceItem{Name: "www", ServiceName: "a_record"},
+			NewDeclaration:   &ChangeInstanceDeclaration{Declaration: map[string]interface{}{"name": "www", "zone": "example.org"}},
+		},
+		{
+			Id:               23,
+			Created:          "2025-03-02T10:00:00Z",
+			ChangeType:       ChangeTypeDelete,
+			ServiceItemField: ServiceItem{Name: "old", Service: ServiceItemService{Name: "a_record"}},
+		},
+		{
+			Id:               24,
+			Created:          "2025-03-02T11:00:00Z",
+			ChangeType:       ChangeTypeCreate,
+			ServiceItemField: ServiceItem{Name: "docs", ServiceName: "cname"},
+			NewDeclaration:   &ChangeInstanceDeclaration{Declaration: map[string]interface{}{"name": "docs"}},
+		},
+	})
+
+	// The latest pending change of www wins, the pending deletion of old is kept and the pending creation of docs added.
+	expected := Submission{
+		"app7": {
+			Services: map[string][]map[string]interface{}{
+				"a_record": {
+					{"name": "www", "zone": "example.net"},
+					{"name": "api", "zone": "example.com"},
+				},
+				"cname": {
+					{"name": "docs"},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(submission, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, submission)
+	}
+}
+
+func TestApplicationLatestSubmissionId(t *testing.T) {
+	var requested string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path + "?" + r.URL.RawQuery
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"count": 3, "next": "http://example.com/next", "previous": null, "results": [{"id": 41}]}`))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	latest, err := client.ApplicationLatestSubmissionId(7)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if latest != 41 {
+		t.Errorf("Expected latest submission 41, got %d", latest)
+	}
+	if requested != "/v1/orcabase/consumer/submissions/?application_id=7&ordering=-id&limit=1" {
+		t.Errorf("Unexpected request %s", requested)
+	}
+}
+
+func TestSubmissionSubmit(t *testing.T) {
+	submission := Submission{
+		"app7": {
+			Metadata: map[string]interface{}{},
+			Services: map[string][]map[string]interface{}{"a_record": {{"name": "www"}}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/orcabase/consumer/submissions/submit/" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("commit_id") != "abc123" || r.URL.Query().Get("partial") != "true" {
+			t.Errorf("Expected commit_id and partial query parameters, got %s", r.URL.RawQuery)
+		}
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("Failed to read request body: %v", err)
+		}
+		var received Submission
+		if err := json.Unmarshal(b, &received); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %v", err)
+		}
+		if received["app7"].Services["a_record"][0]["name"] != "www" {
+			t.Errorf("Unexpected submission body %s", b)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{"id": 41, "commit_id": "abc123"}`))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	result, err := client.SubmissionSubmit(submission, "abc123", true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Id != 41 || result.CommitId != "abc123" {
+		t.Errorf("Expected submission 41 abc123, got %+v", result)
+	}
+}
+
+func TestParseSubmissionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []SubmissionError
+	}{
+		{
+			name: "nested_errors",
+			body: `{"is_valid": false, "errors": {"app7": {"services": {"a_record": [{}, {"zone": ["This field is required.", "Invalid zone."]}]}}}}`,
+			expected: []SubmissionError{
+				{Path: []string{"app7", "services", "a_record", "1", "zone"}, Message: "This field is required."},
+				{Path: []string{"app7", "services", "a_record", "1", "zone"}, Message: "Invalid zone."},
+			},
+		},
+		{
+			name: "top_level_message",
+			body: `{"detail": "Unknown application app8"}`,
+			expected: []SubmissionError{
+				{Path: []string{"detail"}, Message: "Unknown application app8"},
+			},
+		},
+		{
+			name: "not_json",
+			body: `Bad Request`,
+			expected: []SubmissionError{
+				{Path: []string{}, Message: "Bad Request"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := ParseSubmissionErrors([]byte(test.body))
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestSubmissionSubmitValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"app7": {"services": {"a_record": [{"zone": ["This field is required."]}]}}}`))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	_, err := client.SubmissionSubmit(Submission{}, "", false)
+
+	var validationErr *SubmissionValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected a SubmissionValidationError, got %v", err)
+	}
+	if len(validationErr.Errors) != 1 || validationErr.Errors[0].Message != "This field is required." {
+		t.Errorf("Unexpected validation errors %+v", validationErr.Errors)
+	}
+}
+
+func TestSubmissionValidate(t *testing.T) {
+	submission := Submission{
+		"app7": {
+			Metadata: map[string]interface{}{},
+			Services: map[string][]map[string]interface{}{"a_record": {{"name": "www"}, {"name": "api"}}},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		statusCode      int
+		response        string
+		valid           bool
+		errors          int
+		changeInstances int
+	}{
+		{
+			name:            "valid",
+			statusCode:      http.StatusOK,
+			response:        `{"is_valid": true, "change_instances": [{"application": "app7", "service": "a_record", "service_item": "api", "change_type": "CREATE"}]}`,
+			valid:           true,
+			changeInstances: 1,
+		},
+		{
+			name:       "invalid_ok_response",
+			statusCode: http.StatusOK,
+			response:   `{"is_valid": false, "errors": {"app7": {"services": {"a_record": {"1": {"zone": ["This field is required."]}}}}}}`,
+			errors:     1,
+		},
+		{
+			name:       "invalid_bad_request",
+			statusCode: http.StatusBadRequest,
+			response:   `{"app7": {"services": {"a_record": [{}, {"zone": ["This field is required."], "ttl": ["Must be positive."]}]}}}`,
+			errors:     2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/v1/orcabase/consumer/submissions/validate/" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(test.statusCode)
+				_, err := w.Write([]byte(test.response))
+				if err != nil {
+					t.Fatalf("Failed to write mock response: %v", err)
+				}
+			}))
+			defer server.Close()
+
+			apikey := "123456"
+			client := NewClient(&server.URL, &apikey, context.Background())
+
+			result, err := client.SubmissionValidate(submission, false)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result.Valid != test.valid || len(result.Errors) != test.errors || len(result.ChangeInstances) != test.changeInstances {
+				t.Errorf("Expected valid %t with %d errors and %d change instances, got %+v", test.valid, test.errors, test.changeInstances, result)
+			}
+			for _, e := range result.Errors {
+				applicationName, serviceName, name := submission.ErrorServiceItem(e)
+				if applicationName != "app7" || serviceName != "a_record" || name != "api" {
+					t.Errorf("Expected the error %+v to be about app7 a_record api, got %s %s %s", e, applicationName, serviceName, name)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmissionsGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/orcabase/consumer/submissions/" {
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+
+		response := `{"count": 2, "next": null, "previous": null, "results": [{"id": 41, "commit_id": "abc123", "status": "SUCCESS", "created": "2026-10-01T10:00:00Z", "consumer_team": {"id": 1, "name": "alpha"}}]}`
+		if r.URL.Query().Get("page") == "" {
+			response = `{"count": 2, "next": "http://` + r.Host + `/v1/orcabase/consumer/submissions/?page=2", "previous": null, "results": [{"id": 42, "commit_id": "abc123", "status": "FAILED", "created": "2026-10-02T10:00:00Z", "consumer_team": {"id": 1, "name": "alpha"}}]}`
+			if r.URL.RawQuery != "commit_id=abc123&created_after=2026-10-01T00%3A00%3A00%2B01%3A00" {
+				t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	submissions, err := client.SubmissionsGet(&SubmissionQuery{Pov: "consumer", CommitId: "abc123", CreatedAfter: "2026-10-01T00:00:00+01:00"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(submissions) != 2 || submissions[0].Id != 42 || submissions[1].Id != 41 {
+		t.Fatalf("Expected submissions 42 and 41, got %+v", submissions)
+	}
+	if submissions[0].Status != "FAILED" || submissions[0].ConsumerTeam.Name != "alpha" {
+		t.Errorf("Unexpected submission %+v", submissions[0])
+	}
+}
+
+func TestSubmissionQueryGetQueryParam(t *testing.T) {
+	q := SubmissionQuery{Pov: "consumer", ApplicationId: 7, ConsumerTeamId: 1}
+	if result := q.GetQueryParam(); result != "?application_id=7&consumer_team_id=1" {
+		t.Errorf("Expected ?application_id=7&consumer_team_id=1, got %s", result)
+	}
+
+	q = SubmissionQuery{Pov: "consumer", ApplicationId: 7, Ordering: "-id", Limit: 1}
+	if result := q.GetQueryParam(); result != "?application_id=7&ordering=-id&limit=1" {
+		t.Errorf("Expected ?application_id=7&ordering=-id&limit=1, got %s", result)
+	}
+
+	q = SubmissionQuery{Pov: "consumer"}
+	if result := q.GetQueryParam(); result != "" {
+		t.Errorf("Expected an empty query, got %s", result)
+	}
+}
+
+func TestLockApplication(t *testing.T) {
+	apikey := "123456"
+	url := "http://localhost"
+	client := NewClient(&url, &apikey, context.Background())
+
+	unlock := client.LockApplication(7)
+
+	// Another application isn't blocked by the lock of application 7.
+	client.LockApplication(8)()
+
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		client.LockApplication(7)()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("Expected application 7 to stay locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("Expected application 7 to be unlocked")
+	}
+}
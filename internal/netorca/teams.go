@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	TeamTypeConsumer     = "consumer"
+	TeamTypeServiceOwner = "serviceowner"
+)
+
+// TeamTypes are the kinds of team NetOrca has, consumer teams request service items and service owner teams provide
+// the services.
+var TeamTypes = []string{TeamTypeConsumer, TeamTypeServiceOwner}
+
+// teamTypePaths maps each team type to its endpoint.
+var teamTypePaths = map[string]string{
+	TeamTypeConsumer:     "consumer_teams",
+	TeamTypeServiceOwner: "service_owner_teams",
+}
+
+type Team struct {
+	Id       int64       `json:"id"`
+	Name     string      `json:"name"`
+	Metadata interface{} `json:"metadata"`
+
+	// Type is the team type of the endpoint the team was read from.
+	Type string `json:"-"`
+}
+
+type NetOrcaTeam struct {
+	Count    int
+	Next     string
+	Previous string
+	Results  []Team
+}
+
+// TeamQuery filters the teams listed, an empty TeamType lists teams of every type.
+type TeamQuery struct {
+	Pov      string
+	TeamType string
+	Name     string
+}
+
+// MetadataData returns the metadata of the team as an object. Metadata NetOrca holds as a json string is decoded, other
+// strings are returned as they are.
+func (t Team) MetadataData() interface{} {
+	s, ok := t.Metadata.(string)
+	if !ok {
+		return t.Metadata
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil || decoder.More() {
+		return s
+	}
+
+	return decoded
+}
+
+// TeamGetById returns a team of the given team type.
+func (c *NetOrcaClient) TeamGetById(id int64, teamType string, pov string) (Team, error) {
+	teamPath, ok := teamTypePaths[teamType]
+	if !ok {
+		return Team{}, fmt.Errorf("unknown team type %s, expected one of: %s", teamType, strings.Join(TeamTypes, ", "))
+	}
+
+	url := fmt.Sprintf("%s/v1/orcabase/%s/%s/%d/", c.baseUrl, pov, teamPath, id)
+
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return Team{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return Team{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Team{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return Team{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var team Team
+
+	err = json.Unmarshal(b, &team)
+	if err != nil {
+		return Team{}, err
+	}
+
+	team.Type = teamType
+	return team, nil
+}
+
+// TeamsGet returns the teams matching the query from every page of results, consumer teams first when every team type
+// is listed.
+func (c *NetOrcaClient) TeamsGet(q *TeamQuery) ([]Team, error) {
+	teamTypes := TeamTypes
+	if q.TeamType != "" {
+		if _, ok := teamTypePaths[q.TeamType]; !ok {
+			return nil, fmt.Errorf("unknown team type %s, expected one of: %s", q.TeamType, strings.Join(TeamTypes, ", "))
+		}
+		teamTypes = []string{q.TeamType}
+	}
+
+	results := []Team{}
+	for _, teamType := range teamTypes {
+		url := fmt.Sprintf("%s/v1/orcabase/%s/%s/%s", c.baseUrl, q.Pov, teamTypePaths[teamType], q.GetQueryParam())
+
+		for url != "" {
+			teams, err := c.teamsGetPage(url)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range teams.Results {
+				v.Type = teamType
+				results = append(results, v)
+			}
+			url = teams.Next
+		}
+	}
+
+	return results, nil
+}
+
+func (c *NetOrcaClient) teamsGetPage(url string) (NetOrcaTeam, error) {
+	serv, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return NetOrcaTeam{}, err
+	}
+
+	serv.Header.Add("Authorization", c.GetApiKey())
+
+	resp, err := c.client.Do(serv)
+	if err != nil {
+		return NetOrcaTeam{}, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return NetOrcaTeam{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		return NetOrcaTeam{}, fmt.Errorf("http code: %d\n response: %s\nurl: %s\nmethod: GET", resp.StatusCode, b, url)
+	}
+
+	var teams NetOrcaTeam
+
+	err = json.Unmarshal(b, &teams)
+	if err != nil {
+		return NetOrcaTeam{}, err
+	}
+
+	return teams, nil
+}
+
+// Returns the formatted query parmaters for use with the http client.
+// e.g. in the form of ?<field_name>=<field_value>&<field_name>=<field_value>
+func (q TeamQuery) GetQueryParam() string {
+	queryParam := "?"
+
+	if q.Name != "" {
+		queryParam = fmt.Sprintf("%sname=%s&", queryParam, url.QueryEscape(q.Name))
+	}
+
+	// Remove the trailing '&' if it exists
+	if queryParam[len(queryParam)-1] == '&' {
+		queryParam = queryParam[:len(queryParam)-1]
+	}
+
+	// If only '?' remains, return an empty string
+	if queryParam == "?" {
+		return ""
+	}
+
+	return queryParam
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+
+package netorca
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestTeamMetadataData(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata interface{}
+		expected interface{}
+	}{
+		{
+			name:     "object",
+			metadata: map[string]interface{}{"cost_centre": "cc-12"},
+			expected: map[string]interface{}{"cost_centre": "cc-12"},
+		},
+		{
+			name:     "json_string",
+			metadata: `{"cost_centre": "cc-12", "contacts": ["alpha@example.com"], "budget": 100}`,
+			expected: map[string]interface{}{"cost_centre": "cc-12", "contacts": []interface{}{"alpha@example.com"}, "budget": json.Number("100")},
+		},
+		{
+			name:     "plain_string",
+			metadata: "cost centre cc-12",
+			expected: "cost centre cc-12",
+		},
+		{
+			name:     "null",
+			metadata: nil,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := Team{Metadata: test.metadata}.MetadataData()
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Expected %#v, got %#v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestTeamsGet(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+
+		response := `{"count": 1, "next": null, "previous": null, "results": [{"id": 1, "name": "alpha", "metadata": "{\"cost_centre\": \"cc-12\"}"}]}`
+		if r.URL.Path == "/v1/orcabase/consumer/service_owner_teams/" {
+			response = `{"count": 1, "next": null, "previous": null, "results": [{"id": 2, "name": "alpha", "metadata": {"contacts": ["dns@example.com"]}}]}`
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(response))
+		if err != nil {
+			t.Fatalf("Failed to write mock response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	apikey := "123456"
+	client := NewClient(&server.URL, &apikey, context.Background())
+
+	teams, err := client.TeamsGet(&TeamQuery{Pov: "consumer", Name: "alpha"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedPaths := []string{
+		"/v1/orcabase/consumer/consumer_teams/?name=alpha",
+		"/v1/orcabase/consumer/service_owner_teams/?name=alpha",
+	}
+	if !reflect.DeepEqual(paths, expectedPaths) {
+		t.Errorf("Expected requests %v, got %v", expectedPaths, paths)
+	}
+
+	if len(teams) != 2 || teams[0].Type != TeamTypeConsumer || teams[1].Type != TeamTypeServiceOwner {
+		t.Fatalf("Expected a consumer and a service owner team, got %+v", teams)
+	}
+	if !reflect.DeepEqual(teams[0].MetadataData(), map[string]interface{}{"cost_centre": "cc-12"}) {
+		t.Errorf("Unexpected metadata %v", teams[0].MetadataData())
+	}
+
+	_, err = client.TeamsGet(&TeamQuery{Pov: "consumer", TeamType: "admin"})
+	if err == nil || err.Error() != "unknown team type admin, expected one of: consumer, serviceowner" {
+		t.Errorf("Expected an unknown team type error, got %v", err)
+	}
+}
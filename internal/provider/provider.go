@@ -150,7 +150,17 @@ func (p *netOrcaProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *netOrcaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewChangeInstanceDataSource,
+		datasources.NewSingleChangeInstanceDataSource,
 		datasources.NewServiceItemDataSource,
+		datasources.NewSingleServiceItemDataSource,
+		datasources.NewServiceItemGraphDataSource,
+		datasources.NewServiceItemHistoryDataSource,
+		datasources.NewHealthchecksDataSource,
+		datasources.NewSubmissionValidationDataSource,
+		datasources.NewSubmissionsDataSource,
+		datasources.NewApplicationsDataSource,
+		datasources.NewTeamDataSource,
+		datasources.NewSingleTeamDataSource,
 	}
 }
 
@@ -158,5 +168,11 @@ func (p *netOrcaProvider) DataSources(ctx context.Context) []func() datasource.D
 func (p *netOrcaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resouces.NewChangeInstanceResource,
+		resouces.NewChangeInstanceBatchResource,
+		resouces.NewServiceItemDeployedItemResource,
+		resouces.NewServiceItemRuntimeStateResource,
+		resouces.NewServiceItemResource,
+		resouces.NewSubmissionResource,
+		resouces.NewApplicationResource,
 	}
 }
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+
+package resouces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Interface Assertions
+// -----------------------------------------------------------------------------
+
+var (
+	_ resource.Resource                = (*applicationResource)(nil)
+	_ resource.ResourceWithImportState = (*applicationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*applicationResource)(nil)
+)
+
+// -----------------------------------------------------------------------------
+// Constructor and Type Definitions
+// -----------------------------------------------------------------------------
+
+// NewApplicationResource returns a new instance of the applicationResource.
+func NewApplicationResource() resource.Resource {
+	return &applicationResource{}
+}
+
+// applicationResource implements the resource.Resource interface.
+type applicationResource struct {
+	client *netorca.NetOrcaClient
+}
+
+// applicationResourceModel defines the schema model for the resource.
+type applicationResourceModel struct {
+	ID            types.String            `tfsdk:"id"`
+	ApplicationID types.Int64             `tfsdk:"application_id"`
+	Name          types.String            `tfsdk:"name"`
+	Metadata      tfvalues.NormalizedJSON `tfsdk:"metadata"`
+	Owner         types.Int64             `tfsdk:"owner"`
+}
+
+// -----------------------------------------------------------------------------
+// Resource Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the resource type name.
+func (r *applicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+// Schema defines the schema for the resource.
+func (r *applicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a NetOrca application from the consumer POV. The application is owned by the consumer team of the provider's API key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NetOrca application ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The NetOrca application ID.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the application, used as its key in submissions.",
+			},
+			"metadata": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				CustomType:  tfvalues.NormalizedJSONType{},
+				Description: "A json object of metadata attached to the application. Left as NetOrca holds it when not set, {} for a new application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the consumer team that owns the application.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client on the resource.
+func (r *applicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the application.
+func (r *applicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	application, err := r.client.ApplicationCreate(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating application %s", plan.Name.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setApplication(application)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read retrieves the application, removing it from state when it no longer exists.
+func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	application, err := r.client.ApplicationGetById(state.ApplicationID.ValueInt64(), netorca.PovConsumer)
+	if netorca.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting application id: %d", state.ApplicationID.ValueInt64()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setApplication(application)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the name and metadata of the application.
+func (r *applicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan applicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	application, err := r.client.ApplicationUpdate(plan.ApplicationID.ValueInt64(), plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating application id: %d", plan.ApplicationID.ValueInt64()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setApplication(application)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the application.
+func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ApplicationDelete(state.ApplicationID.ValueInt64())
+	if err != nil && !netorca.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting application id: %d", state.ApplicationID.ValueInt64()), err.Error())
+	}
+}
+
+// ImportState imports an existing application by its ID.
+func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error parsing NetOrca application ID from terraform ID: %s", req.ID),
+			"Expected the import ID to be a numeric application ID, e.g. 123.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), id)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Functions
+// -----------------------------------------------------------------------------
+
+// request returns the application fields to send, metadata is left out while it is unknown.
+func (m *applicationResourceModel) request() netorca.ApplicationRequest {
+	return netorca.ApplicationRequest{
+		Name:     m.Name.ValueString(),
+		Metadata: m.Metadata.ValueString(),
+	}
+}
+
+// setApplication populates the model from an application.
+func (m *applicationResourceModel) setApplication(application netorca.NetOrcaApplication) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metadata := application.Metadata
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling metadata from application id: %d", application.Id), err.Error())
+		return diags
+	}
+
+	m.ID = types.StringValue(strconv.FormatInt(application.Id, 10))
+	m.ApplicationID = types.Int64Value(application.Id)
+	m.Name = types.StringValue(application.Name)
+	m.Metadata = tfvalues.NewNormalizedJSONValue(string(b))
+	m.Owner = types.Int64Value(application.Owner)
+
+	return diags
+}
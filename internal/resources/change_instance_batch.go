@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+
+package resouces
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// changeInstanceBatchDefaultConcurrency is how many change instances are updated at once when max_concurrency isn't
+// configured.
+const changeInstanceBatchDefaultConcurrency = 5
+
+// -----------------------------------------------------------------------------
+// Interface Assertions
+// -----------------------------------------------------------------------------
+
+var (
+	_ resource.Resource                   = (*changeInstanceBatchResource)(nil)
+	_ resource.ResourceWithConfigure      = (*changeInstanceBatchResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*changeInstanceBatchResource)(nil)
+)
+
+// -----------------------------------------------------------------------------
+// Constructor and Type Definitions
+// -----------------------------------------------------------------------------
+
+// NewChangeInstanceBatchResource returns a new instance of the changeInstanceBatchResource.
+func NewChangeInstanceBatchResource() resource.Resource {
+	return &changeInstanceBatchResource{}
+}
+
+// changeInstanceBatchResource implements the resource.Resource interface.
+type changeInstanceBatchResource struct {
+	client *netorca.NetOrcaClient
+}
+
+// changeInstanceBatchResourceModel defines the schema model for the resource.
+type changeInstanceBatchResourceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	POV            types.String            `tfsdk:"pov"`
+	State          types.String            `tfsdk:"state"`
+	Description    types.String            `tfsdk:"description"`
+	DeployedItem   tfvalues.NormalizedJSON `tfsdk:"deployed_item"`
+	MaxConcurrency types.Int64             `tfsdk:"max_concurrency"`
+	Triggers       types.Map               `tfsdk:"triggers"`
+	Filters        types.Object            `tfsdk:"filters"`
+
+	MatchedCount   types.Int64 `tfsdk:"matched_count"`
+	UpdatedCount   types.Int64 `tfsdk:"updated_count"`
+	UnchangedCount types.Int64 `tfsdk:"unchanged_count"`
+	FailedCount    types.Int64 `tfsdk:"failed_count"`
+	Results        types.List  `tfsdk:"results"`
+}
+
+// changeInstanceBatchFiltersModel holds the filters selecting the change instances of the batch.
+type changeInstanceBatchFiltersModel struct {
+	ApplicationId      types.Int64  `tfsdk:"application_id"`
+	ChangeType         types.String `tfsdk:"change_type"`
+	CommitId           types.String `tfsdk:"commit_id"`
+	ConsumerTeamId     types.Int64  `tfsdk:"consumer_team_id"`
+	ServiceId          types.Int64  `tfsdk:"service_id"`
+	ServiceItemId      types.Int64  `tfsdk:"service_item_id"`
+	ServiceName        types.String `tfsdk:"service_name"`
+	ServiceOwnerTeamId types.Int64  `tfsdk:"service_owner_team_id"`
+	State              types.String `tfsdk:"state"`
+	SubmissionId       types.Int64  `tfsdk:"submission_id"`
+}
+
+// -----------------------------------------------------------------------------
+// Resource Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the resource type name.
+func (r *changeInstanceBatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_change_instance_batch"
+}
+
+// Schema defines the schema for the resource.
+func (r *changeInstanceBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Moves every NetOrca change instance matching the filters to a state in a single apply. " +
+			"Change instances are processed on create and whenever the configuration or triggers change. " +
+			"A failed change instance doesn't stop the others, it is recorded in results. Destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Terraform ID of the batch. Structured as {pov}/{hash of the filters}",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pov": schema.StringAttribute{
+				Required:    true,
+				Description: "The NetOrca Point Of View (pov) of the change instances (serviceowner|consumer)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Required:    true,
+				Description: "The state every matching change instance is moved to e.g. APPROVED|REJECTED. Change instances already in this state are left unchanged.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.ChangeInstanceStates...),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The description sent to NetOrca with each update, e.g. the reason for a rejection. Required when state is %s. Defaults to %q.",
+					strings.Join(netorca.ChangeInstanceFailedStates, " or "), changeInstanceDefaultDescription),
+			},
+			"deployed_item": schema.StringAttribute{
+				Required:   true,
+				CustomType: tfvalues.NormalizedJSONType{},
+				Description: "A json template of the deployed_item set on each change instance. `{{ path }}` placeholders are filled from the change instance, " +
+					"using the path syntax of where conditions e.g. `{\"name\": \"{{ service_item.declaration.name }}\"}`. " +
+					"A string made of a single placeholder keeps the json type of the value found.",
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(changeInstanceBatchDefaultConcurrency),
+				Description: fmt.Sprintf("The maximum number of change instances updated at once. Defaults to %d.", changeInstanceBatchDefaultConcurrency),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that reprocess the matching change instances when changed, e.g. to pick up newly submitted ones.",
+			},
+			"matched_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of change instances matching the filters at the last apply.",
+			},
+			"updated_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of change instances moved to state at the last apply.",
+			},
+			"unchanged_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of change instances already in state at the last apply.",
+			},
+			"failed_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of change instances that failed to update at the last apply.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "What happened to each matching change instance at the last apply.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"change_instance_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"service_item_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"service_item_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"previous_state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the change instance before the update.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the change instance after the update.",
+						},
+						"result": schema.StringAttribute{
+							Computed:    true,
+							Description: "The outcome of the update (updated|unchanged|failed).",
+						},
+						"error": schema.StringAttribute{
+							Computed:    true,
+							Description: "Why the update failed, empty unless result is failed.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filters": schema.SingleNestedBlock{
+				Description: "Selects the change instances of the batch, at least one filter is required. " +
+					"The batch's ID is derived from the filters, so changing them replaces the batch.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"application_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"change_type": schema.StringAttribute{
+						Optional: true,
+					},
+					"commit_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"consumer_team_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"service_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"service_item_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"service_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"service_owner_team_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"state": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(netorca.ChangeInstanceStates...),
+						},
+					},
+					"submission_id": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client on the resource.
+func (r *changeInstanceBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig ensures the batch is scoped by a filter and a reason is given when rejecting or erroring.
+func (r *changeInstanceBatchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data changeInstanceBatchResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Filters.IsUnknown() {
+		scoped := false
+		for _, v := range data.Filters.Attributes() {
+			if !v.IsNull() {
+				scoped = true
+				break
+			}
+		}
+		if !scoped {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("filters"),
+				"Missing change instance filters",
+				"Set at least one filter in the filters block, a batch can't update every change instance.",
+			)
+		}
+	}
+
+	if data.State.IsNull() || data.State.IsUnknown() || data.Description.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(netorca.ChangeInstanceFailedStates, data.State.ValueString()) && strings.TrimSpace(data.Description.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Missing description",
+			fmt.Sprintf("A description giving the reason is required when moving change instances to state %s.", data.State.ValueString()),
+		)
+	}
+}
+
+// Create processes the matching change instances.
+func (r *changeInstanceBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan changeInstanceBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.process(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the results of the last apply, which can't be read back from NetOrca.
+func (r *changeInstanceBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state changeInstanceBatchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+}
+
+// Update processes the matching change instances again.
+func (r *changeInstanceBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan changeInstanceBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.process(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is a no-op since the processed change instances are left as they are.
+func (r *changeInstanceBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state changeInstanceBatchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Functions
+// -----------------------------------------------------------------------------
+
+// process updates every change instance matching the filters and records the results in the model. Failed change
+// instances are reported as a warning, so the results are still saved.
+func (r *changeInstanceBatchResource) process(ctx context.Context, m *changeInstanceBatchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var filters changeInstanceBatchFiltersModel
+	diags.Append(m.Filters.As(ctx, &filters, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	query, err := netorca.NewChangeInstanceQuery(map[string]interface{}{
+		"pov":                   m.POV.ValueString(),
+		"application_id":        filters.ApplicationId.ValueInt64(),
+		"change_type":           filters.ChangeType.ValueString(),
+		"commit_id":             filters.CommitId.ValueString(),
+		"consumer_team_id":      filters.ConsumerTeamId.ValueInt64(),
+		"service_id":            filters.ServiceId.ValueInt64(),
+		"service_item_id":       filters.ServiceItemId.ValueInt64(),
+		"service_name":          filters.ServiceName.ValueString(),
+		"service_owner_team_id": filters.ServiceOwnerTeamId.ValueInt64(),
+		"state":                 filters.State.ValueString(),
+		"submission_id":         filters.SubmissionId.ValueInt64(),
+	})
+	if err != nil {
+		diags.AddError("Error creating change instance query", err.Error())
+		return diags
+	}
+
+	// A batch must never update every change instance the POV can see, so an unscoped query is refused.
+	if query.GetQueryParam() == "" {
+		diags.AddAttributeError(
+			path.Root("filters"),
+			"Missing change instance filters",
+			"None of the filters are sent to NetOrca, set at least one filter with a non-empty value.",
+		)
+		return diags
+	}
+
+	changeInstances, err := r.client.ChangeInstanceGetAll(query)
+	if err != nil {
+		diags.AddError("Error getting change instances", err.Error())
+		return diags
+	}
+
+	description := changeInstanceDefaultDescription
+	if !m.Description.IsNull() {
+		description = m.Description.ValueString()
+	}
+
+	results := r.client.ChangeInstanceBatchUpdate(ctx, m.POV.ValueString(), changeInstances, netorca.ChangeInstanceBatchRequest{
+		State:        m.State.ValueString(),
+		Description:  description,
+		DeployedItem: m.DeployedItem.ValueString(),
+	}, int(m.MaxConcurrency.ValueInt64()))
+
+	hash := sha256.Sum256([]byte(query.GetQueryParam()))
+	m.ID = types.StringValue(fmt.Sprintf("%s/%s", m.POV.ValueString(), hex.EncodeToString(hash[:])[:16]))
+
+	diags.Append(m.setResults(results)...)
+	if m.FailedCount.ValueInt64() > 0 {
+		var failures []string
+		for _, v := range results {
+			if v.Result == netorca.ChangeInstanceBatchFailed {
+				failures = append(failures, fmt.Sprintf("change instance id: %d: %s", v.ChangeInstanceId, v.Error))
+			}
+		}
+		diags.AddWarning(
+			fmt.Sprintf("%d of %d change instances failed to update", m.FailedCount.ValueInt64(), m.MatchedCount.ValueInt64()),
+			strings.Join(failures, "\n"),
+		)
+	}
+
+	return diags
+}
+
+// setResults populates the counts and results of the model.
+func (m *changeInstanceBatchResourceModel) setResults(results []netorca.ChangeInstanceBatchResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	counts := map[string]int64{}
+	elems := []attr.Value{}
+
+	for _, v := range results {
+		counts[v.Result]++
+
+		obj, d := types.ObjectValue(changeInstanceBatchResultAttrTypes, map[string]attr.Value{
+			"change_instance_id": types.Int64Value(v.ChangeInstanceId),
+			"service_item_id":    types.Int64Value(v.ServiceItemId),
+			"service_item_name":  types.StringValue(v.ServiceItemName),
+			"previous_state":     types.StringValue(v.PreviousState),
+			"state":              types.StringValue(v.State),
+			"result":             types.StringValue(v.Result),
+			"error":              types.StringValue(v.Error),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: changeInstanceBatchResultAttrTypes}, elems)
+	diags.Append(d...)
+
+	m.Results = list
+	m.MatchedCount = types.Int64Value(int64(len(results)))
+	m.UpdatedCount = types.Int64Value(counts[netorca.ChangeInstanceBatchUpdated])
+	m.UnchangedCount = types.Int64Value(counts[netorca.ChangeInstanceBatchUnchanged])
+	m.FailedCount = types.Int64Value(counts[netorca.ChangeInstanceBatchFailed])
+
+	return diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var changeInstanceBatchResultAttrTypes = map[string]attr.Type{
+	"change_instance_id": types.Int64Type,
+	"service_item_id":    types.Int64Type,
+	"service_item_name":  types.StringType,
+	"previous_state":     types.StringType,
+	"state":              types.StringType,
+	"result":             types.StringType,
+	"error":              types.StringType,
+}
@@ -6,26 +6,57 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"slices"
 	"strings"
+	"time"
 
 	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	// changeInstanceDefaultTimeout is how long to wait for wait_for_state when no timeout is configured.
+	changeInstanceDefaultTimeout = 30 * time.Minute
+	// changeInstanceDefaultDescription is sent with each update when no description is configured.
+	changeInstanceDefaultDescription = "Updated via terraform"
+
+	// changeInstanceImportDefaultPov is the POV of an import ID without one.
+	changeInstanceImportDefaultPov = netorca.PovServiceOwner
+
+	changeInstanceOnDestroyNoop     = "noop"
+	changeInstanceOnDestroyWarn     = "warn"
+	changeInstanceOnDestroySetState = "set_state"
+)
+
+// changeInstanceOnDestroyOptions lists the supported on_destroy behaviours.
+var changeInstanceOnDestroyOptions = []string{changeInstanceOnDestroyNoop, changeInstanceOnDestroyWarn, changeInstanceOnDestroySetState}
+
 // -----------------------------------------------------------------------------
 // Interface Assertions
 // -----------------------------------------------------------------------------
 
 var (
-	_ resource.Resource                = (*changeInstancesResource)(nil)
-	_ resource.ResourceWithImportState = (*changeInstancesResource)(nil)
-	_ resource.ResourceWithConfigure   = (*changeInstancesResource)(nil)
+	_ resource.Resource                   = (*changeInstancesResource)(nil)
+	_ resource.ResourceWithImportState    = (*changeInstancesResource)(nil)
+	_ resource.ResourceWithConfigure      = (*changeInstancesResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*changeInstancesResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*changeInstancesResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*changeInstancesResource)(nil)
 )
 
 // -----------------------------------------------------------------------------
@@ -44,6 +75,33 @@ type changeInstancesResource struct {
 
 // changeInstanceResourceModel defines the schema model for the resource.
 type changeInstanceResourceModel struct {
+	ID               types.String            `tfsdk:"id"`
+	ChangeInstanceID types.Int64             `tfsdk:"change_instance_id"`
+	POV              types.String            `tfsdk:"pov"`
+	State            types.String            `tfsdk:"state"`
+	CurrentState     types.String            `tfsdk:"current_state"`
+	DeployedItem     tfvalues.NormalizedJSON `tfsdk:"deployed_item"`
+	ChangeType       types.String            `tfsdk:"change_type"`
+	ServiceItemID    types.Int64             `tfsdk:"service_item_id"`
+
+	RetiresServiceItem types.Bool `tfsdk:"retires_service_item"`
+
+	Description     types.String `tfsdk:"description"`
+	LastDescription types.String `tfsdk:"last_description"`
+
+	DeployedItemValue types.Dynamic `tfsdk:"deployed_item_value"`
+
+	WaitForState types.List     `tfsdk:"wait_for_state"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+
+	OnDestroy           types.String            `tfsdk:"on_destroy"`
+	DestroyState        types.String            `tfsdk:"destroy_state"`
+	DestroyDescription  types.String            `tfsdk:"destroy_description"`
+	DestroyDeployedItem tfvalues.NormalizedJSON `tfsdk:"destroy_deployed_item"`
+}
+
+// changeInstanceResourceModelV0 is the schema model of version 0, where id was the configured change instance ID.
+type changeInstanceResourceModelV0 struct {
 	ID           types.Int64  `tfsdk:"id"`
 	POV          types.String `tfsdk:"pov"`
 	State        types.String `tfsdk:"state"`
@@ -62,24 +120,126 @@ func (d *changeInstancesResource) Metadata(ctx context.Context, req resource.Met
 // Schema defines the schema for the resource.
 func (d *changeInstancesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages NetOrca change instances.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Terraform ID of the change instance. Structured as {pov}/{change_instance_id}",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"change_instance_id": schema.Int64Attribute{
 				Required:    true,
-				Description: "The NetOrca change instance ID. Structured as {pov}/{change_instance_id}",
+				Description: "The NetOrca change instance ID.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"pov": schema.StringAttribute{
 				Required:    true,
-				Description: "The NetOrca Point Of View (pov) of the change instance",
+				Description: "The NetOrca Point Of View (pov) of the change instance (serviceowner|consumer)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.Povs...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"state": schema.StringAttribute{
-				Optional:    true,
-				Description: "Sets the current state of a change instance e.g. APPROVED|ERROR|COMPLETED",
+				Optional: true,
+				Computed: true,
+				Description: "Sets the current state of a change instance e.g. APPROVED|ERROR|COMPLETED. When not set the state is left unchanged. " +
+					"The state isn't reported as drifted when the change instance has only progressed from APPROVED to COMPLETED, any other change e.g. to REJECTED or ERROR is.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.ChangeInstanceStates...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the change instance as last read from NetOrca, e.g. after waiting for wait_for_state.",
 			},
 			"deployed_item": schema.StringAttribute{
-				Required:    true,
-				Description: "An arbitrary json blob used to attach metadata to change instances.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: tfvalues.NormalizedJSONType{},
+				Description: "An arbitrary json blob used to attach metadata to change instances. Differences in whitespace, key order or number formatting aren't changes. " +
+					"Required unless change_type is DELETE. When left out of a DELETE change, it is cleared once the change instance is COMPLETED.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"change_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of change (CREATE|MODIFY|DELETE).",
+			},
+			"service_item_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the service item the change instance is for.",
+			},
+			"retires_service_item": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether completing the change instance retires its service item, i.e. change_type is DELETE.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The description sent to NetOrca with each update, e.g. the reason for a rejection. Required when state is %s. Defaults to %q. ",
+					strings.Join(netorca.ChangeInstanceFailedStates, " or "), changeInstanceDefaultDescription) +
+					"Changing only the description doesn't update the change instance.",
+			},
+			"last_description": schema.StringAttribute{
+				Computed: true,
+				Description: "The last description NetOrca recorded for the change instance. When NetOrca doesn't return it, it is best-effort " +
+					"taken from the last line of the change instance log, so only the last line of a multi-line description is kept.",
+			},
+			"deployed_item_value": schema.DynamicAttribute{
+				Computed: true,
+				Description: "The deployed_item as recorded by NetOrca, decoded into an object. After waiting for wait_for_state it may differ from deployed_item, " +
+					"e.g. when downstream automation updated it in the meantime.",
 			},
+			"wait_for_state": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "After each update, wait until the change instance reaches one of these states e.g. [\"COMPLETED\"]. " +
+					"Fails if the change instance reaches ERROR or REJECTED first, unless they are listed.",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(changeInstanceOnDestroyNoop),
+				Description: "What happens to the change instance when the resource is destroyed (noop|warn|set_state). " +
+					"noop leaves it as it is, warn leaves it as it is with a warning and set_state moves it to destroy_state. Defaults to noop.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(changeInstanceOnDestroyOptions...),
+				},
+			},
+			"destroy_state": schema.StringAttribute{
+				Optional:    true,
+				Description: "The state the change instance is moved to on destroy e.g. ERROR. Required when on_destroy is set_state.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.ChangeInstanceStates...),
+				},
+			},
+			"destroy_description": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The description sent to NetOrca on destroy. Required when destroy_state is %s. Defaults to %q.",
+					strings.Join(netorca.ChangeInstanceFailedStates, " or "), changeInstanceDefaultDescription),
+			},
+			"destroy_deployed_item": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  tfvalues.NormalizedJSONType{},
+				Description: "The deployed_item set on destroy, e.g. to roll it back. Defaults to the deployed_item NetOrca holds at the time.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -101,45 +261,237 @@ func (c *changeInstancesResource) Configure(ctx context.Context, req resource.Co
 	c.client = client
 }
 
-// Create updates a change instance and then refreshes the state.
-func (c *changeInstancesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+// ValidateConfig ensures the destroy settings are consistent.
+func (c *changeInstancesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data changeInstanceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validateDestroyConfig()...)
+}
+
+// UpgradeState migrates state from previous schema versions.
+func (c *changeInstancesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 used the change instance ID as a configured Int64 id.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Required: true,
+					},
+					"pov": schema.StringAttribute{
+						Required: true,
+					},
+					"state": schema.StringAttribute{
+						Optional: true,
+					},
+					"deployed_item": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior changeInstanceResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if prior.ID.ValueInt64() == 0 {
+					resp.Diagnostics.AddError(
+						"Unable to upgrade change instance state",
+						"The stored state has no change instance ID. Remove it with `terraform state rm` and import the change instance again.",
+					)
+					return
+				}
+
+				upgraded := changeInstanceResourceModel{
+					ChangeInstanceID:    prior.ID,
+					POV:                 prior.POV,
+					State:               prior.State,
+					CurrentState:        prior.State,
+					DeployedItem:        tfvalues.NormalizedJSON{StringValue: prior.DeployedItem},
+					ChangeType:          types.StringNull(),
+					ServiceItemID:       types.Int64Null(),
+					RetiresServiceItem:  types.BoolNull(),
+					Description:         types.StringNull(),
+					LastDescription:     types.StringNull(),
+					WaitForState:        types.ListNull(types.StringType),
+					Timeouts:            changeInstanceTimeoutsNull(),
+					OnDestroy:           types.StringValue(changeInstanceOnDestroyNoop),
+					DestroyState:        types.StringNull(),
+					DestroyDescription:  types.StringNull(),
+					DestroyDeployedItem: tfvalues.NewNormalizedJSONNull(),
+				}
+				upgraded.ID = types.StringValue(changeInstanceTerraformID(prior.POV.ValueString(), prior.ID.ValueInt64()))
+
+				var deployedItem interface{}
+				upgraded.DeployedItemValue = types.DynamicNull()
+				if err := json.Unmarshal([]byte(prior.DeployedItem.ValueString()), &deployedItem); err == nil {
+					deployedItemValue, diags := tfvalues.DynamicFromJSON(deployedItem)
+					resp.Diagnostics.Append(diags...)
+					upgraded.DeployedItemValue = deployedItemValue
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
+
+// ModifyPlan validates the planned state transition against the change instance's current state, and on destroy the
+// transition to destroy_state.
+func (c *changeInstancesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		c.validateDestroyTransition(ctx, req, resp)
+		return
+	}
+
 	var plan changeInstanceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	content := netorca.ChangeInstanceUpdateRequest{
-		State:        plan.State.ValueString(),
-		Description:  "Updated via terraform",
-		DeployedItem: plan.DeployedItem.ValueString(),
+	if plan.ChangeInstanceID.IsUnknown() || plan.POV.IsUnknown() {
+		return
+	}
+
+	var state changeInstanceResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	err := c.client.ChangeInstancePatch(plan.ID.ValueInt64(), plan.POV.ValueString(), content)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error updating change instance id: %d", plan.ID.ValueInt64()), err.Error())
+	// The refreshed state is used where possible, a new or replaced change instance is looked up instead.
+	var current, changeType string
+	var serviceItemID int64
+	sameChangeInstance := !req.State.Raw.IsNull() && state.ChangeInstanceID.Equal(plan.ChangeInstanceID) && state.POV.Equal(plan.POV)
+	if sameChangeInstance && !state.ChangeType.IsNull() {
+		current = state.CurrentState.ValueString()
+		changeType = state.ChangeType.ValueString()
+		serviceItemID = state.ServiceItemID.ValueInt64()
+	} else {
+		if c.client == nil {
+			return
+		}
+		changeInstance, err := c.client.ChangeInstanceGetById(plan.ChangeInstanceID.ValueInt64(), plan.POV.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", plan.ChangeInstanceID.ValueInt64()), err.Error())
+			return
+		}
+		current = changeInstance.State
+		changeType = changeInstance.ChangeType
+		serviceItemID = changeInstance.ServiceItemField.Id
+	}
+
+	// The change type of a change instance never changes, so it is known when planning.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("change_type"), changeType)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("service_item_id"), serviceItemID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("retires_service_item"), changeType == netorca.ChangeTypeDelete)...)
+
+	var configDeployedItem tfvalues.NormalizedJSON
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deployed_item"), &configDeployedItem)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	changeInstance, err := c.client.ChangeInstanceGetById(plan.ID.ValueInt64(), plan.POV.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", plan.ID.ValueInt64()), err.Error())
+	if configDeployedItem.IsNull() {
+		if changeType != netorca.ChangeTypeDelete {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deployed_item"),
+				"Missing deployed_item",
+				fmt.Sprintf("Change instance id: %d is a %s change, deployed_item can only be left out for %s changes.", plan.ChangeInstanceID.ValueInt64(), changeType, netorca.ChangeTypeDelete),
+			)
+			return
+		}
+
+		// The deployed_item of a retired service item is cleared when its DELETE change is completed.
+		if plan.State.ValueString() == netorca.ChangeInstanceStateCompleted && !(sameChangeInstance && state.State.Equal(plan.State)) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deployed_item"), tfvalues.NewNormalizedJSONValue("{}"))...)
+		}
+	}
+
+	if plan.State.IsNull() || plan.State.IsUnknown() {
 		return
 	}
 
-	plan.ID = types.Int64Value(plan.ID.ValueInt64())
-	plan.POV = types.StringValue("serviceowner")
-	plan.State = types.StringValue(changeInstance.State)
+	// An unchanged state isn't patched, so there is no transition to validate.
+	if sameChangeInstance && state.State.Equal(plan.State) {
+		return
+	}
 
-	jsonBytes, err := json.Marshal(changeInstance.ServiceItemField.DeployedItem)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error marshalling deployed_item from service_item: %d", plan.ID.ValueInt64()), err.Error())
+	// A reason is only required when the change instance is moved to a failed state, so imported change instances
+	// already in one plan cleanly.
+	if current != plan.State.ValueString() && slices.Contains(netorca.ChangeInstanceFailedStates, plan.State.ValueString()) &&
+		!plan.Description.IsUnknown() && strings.TrimSpace(plan.Description.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Missing description",
+			fmt.Sprintf("A description giving the reason is required when moving a change instance to state %s.", plan.State.ValueString()),
+		)
+	}
+
+	if err := netorca.ValidateChangeInstanceTransition(plan.POV.ValueString(), current, plan.State.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid state transition",
+			fmt.Sprintf("Change instance id: %d can't be moved to state %s: %s", plan.ChangeInstanceID.ValueInt64(), plan.State.ValueString(), err.Error()),
+		)
+	}
+}
+
+// Create updates a change instance and then refreshes the state.
+func (c *changeInstancesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan changeInstanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.DeployedItem = types.StringValue(string(jsonBytes))
 
-	diags := resp.State.Set(ctx, plan)
+	createTimeout, diags := plan.Timeouts.Create(ctx, changeInstanceDefaultTimeout)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing is patched when neither state nor deployed_item is set, e.g. for a DELETE change that is only tracked.
+	if !plan.State.IsUnknown() || !plan.DeployedItem.IsUnknown() {
+		content := netorca.ChangeInstanceUpdateRequest{
+			State:        plan.State.ValueString(),
+			Description:  plan.description(),
+			DeployedItem: plan.DeployedItem.ValueString(),
+		}
+
+		err := c.client.ChangeInstancePatch(plan.ChangeInstanceID.ValueInt64(), plan.POV.ValueString(), content)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating change instance id: %d", plan.ChangeInstanceID.ValueInt64()), err.Error())
+			return
+		}
+	}
+
+	changeInstance, waitErr := c.waitForState(ctx, plan, createTimeout)
+	if waitErr != nil && changeInstance.Id == 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", plan.ChangeInstanceID.ValueInt64()), waitErr.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setAppliedChangeInstance(changeInstance)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The change instance was patched, so it is saved in state even when waiting failed.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if waitErr != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for change instance id: %d", plan.ChangeInstanceID.ValueInt64()), waitErr.Error())
+	}
 }
 
 // Read retrieves the current state of the resource.
@@ -150,24 +502,19 @@ func (c *changeInstancesResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("ID is: %d", state.ID.ValueInt64()))
+	tflog.Info(ctx, fmt.Sprintf("ID is: %s", state.ID.ValueString()))
 
-	changeInstance, err := c.client.ChangeInstanceGetById(state.ID.ValueInt64(), state.POV.ValueString())
+	changeInstance, err := c.client.ChangeInstanceGetById(state.ChangeInstanceID.ValueInt64(), state.POV.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", state.ID.ValueInt64()), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", state.ChangeInstanceID.ValueInt64()), err.Error())
 		return
 	}
 
-	state.ID = types.Int64Value(state.ID.ValueInt64())
-	state.POV = types.StringValue(state.POV.ValueString())
-	state.State = types.StringValue(changeInstance.State)
-
-	deployedItemData, err := json.Marshal(changeInstance.ServiceItemField.DeployedItem)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error marshalling service_item.deployed_item.data from change instance id: %d", state.ID.ValueInt64()), err.Error())
+	resp.Diagnostics.Append(state.setRefreshedChangeInstance(changeInstance)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.DeployedItem = types.StringValue(string(deployedItemData))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -183,54 +530,123 @@ func (c *changeInstancesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, changeInstanceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patched := false
 	if !plan.State.Equal(state.State) || !plan.DeployedItem.Equal(state.DeployedItem) {
 		content := netorca.ChangeInstanceUpdateRequest{
 			State:        plan.State.ValueString(),
-			Description:  "Updated via terraform",
+			Description:  plan.description(),
 			DeployedItem: plan.DeployedItem.ValueString(),
 		}
 
-		err := c.client.ChangeInstancePatch(plan.ID.ValueInt64(), plan.POV.ValueString(), content)
+		err := c.client.ChangeInstancePatch(plan.ChangeInstanceID.ValueInt64(), plan.POV.ValueString(), content)
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error updating change instance id: %s", plan.ID.String()), err.Error())
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating change instance id: %d", plan.ChangeInstanceID.ValueInt64()), err.Error())
 			return
 		}
+		patched = true
 	}
 
-	changeInstance, err := c.client.ChangeInstanceGetById(plan.ID.ValueInt64(), plan.POV.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error retrieving change instance id: %s", plan.ID.String()), err.Error())
+	var changeInstance netorca.ChangeInstance
+	var waitErr error
+	if patched {
+		changeInstance, waitErr = c.waitForState(ctx, plan, updateTimeout)
+	} else {
+		changeInstance, waitErr = c.client.ChangeInstanceGetById(plan.ChangeInstanceID.ValueInt64(), plan.POV.ValueString())
+	}
+	if waitErr != nil && changeInstance.Id == 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error retrieving change instance id: %d", plan.ChangeInstanceID.ValueInt64()), waitErr.Error())
 		return
 	}
 
-	deployedItemData, err := json.Marshal(changeInstance.ServiceItemField.DeployedItem)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error marshalling service_item.deployed_item.data from change instance id: %d", state.ID.ValueInt64()), err.Error())
+	resp.Diagnostics.Append(plan.setAppliedChangeInstance(changeInstance)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.ID = types.Int64Value(changeInstance.Id)
-	state.State = types.StringValue(changeInstance.State)
-	state.DeployedItem = types.StringValue(string(deployedItemData))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if waitErr != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for change instance id: %d", plan.ChangeInstanceID.ValueInt64()), waitErr.Error())
+	}
 }
 
-// Delete is a no-op since change instances cannot be deleted.
-func (d *changeInstancesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+// Delete applies on_destroy, as change instances cannot be deleted.
+func (c *changeInstancesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state changeInstanceResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ChangeInstanceID.ValueInt64()
+
+	switch state.OnDestroy.ValueString() {
+	case changeInstanceOnDestroyWarn:
+		resp.Diagnostics.AddWarning(
+			"Change instance left unchanged",
+			fmt.Sprintf("Change instance id: %d is no longer managed by Terraform and was left in state %s.", id, state.CurrentState.ValueString()),
+		)
+	case changeInstanceOnDestroySetState:
+		changeInstance, err := c.client.ChangeInstanceGetById(id, state.POV.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", id), err.Error())
+			return
+		}
+
+		// Nothing to change when the change instance is already in destroy_state and deployed_item isn't rolled back.
+		if changeInstance.State == state.DestroyState.ValueString() && state.DestroyDeployedItem.IsNull() {
+			return
+		}
+
+		if err := netorca.ValidateChangeInstanceTransition(state.POV.ValueString(), changeInstance.State, state.DestroyState.ValueString()); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating change instance id: %d on destroy", id), err.Error())
+			return
+		}
+
+		deployedItem := state.DestroyDeployedItem.ValueString()
+		if state.DestroyDeployedItem.IsNull() {
+			deployedItemData, err := json.Marshal(changeInstance.ServiceItemField.DeployedItem)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error marshalling service_item.deployed_item.data from change instance id: %d", id), err.Error())
+				return
+			}
+			deployedItem = string(deployedItemData)
+		}
+
+		description := changeInstanceDefaultDescription
+		if !state.DestroyDescription.IsNull() {
+			description = state.DestroyDescription.ValueString()
+		}
+
+		content := netorca.ChangeInstanceUpdateRequest{
+			State:        state.DestroyState.ValueString(),
+			Description:  description,
+			DeployedItem: deployedItem,
+		}
+
+		if err := c.client.ChangeInstancePatch(id, state.POV.ValueString(), content); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating change instance id: %d on destroy", id), err.Error())
+		}
+	}
 }
 
-// ImportState handles importing an existing resource into Terraform state.
+// ImportState handles importing an existing resource into Terraform state, from an ID of the form
+// {pov}/{change_instance_id} or a bare change instance ID. Works with import blocks and -generate-config-out.
 func (c *changeInstancesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var state changeInstanceResourceModel
 
-	idTokens := strings.Split(req.ID, "/")
-	pov := idTokens[0]
-	id, err := strconv.ParseInt(idTokens[1], 10, 64)
+	pov, id, err := netorca.ParseChangeInstanceID(req.ID, changeInstanceImportDefaultPov)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error parsing NetOrca change instance ID from terraform ID: %s", req.ID), err.Error())
+		resp.Diagnostics.AddError(
+			"Invalid change instance import ID",
+			fmt.Sprintf("Error parsing import ID %q: %s.\nExpected {pov}/{change_instance_id} e.g. serviceowner/123, "+
+				"or a change instance ID which is imported from the %s POV.", req.ID, err.Error(), changeInstanceImportDefaultPov),
+		)
 		return
 	}
 
@@ -240,16 +656,19 @@ func (c *changeInstancesResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	deployedItemData, err := json.Marshal(config.ServiceItemField.DeployedItem)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error marshalling service_item.deployed_item.data from change instance id: %d", state.ID.ValueInt64()), err.Error())
+	state.POV = types.StringValue(pov)
+	state.Description = types.StringNull()
+	state.OnDestroy = types.StringValue(changeInstanceOnDestroyNoop)
+	state.DestroyState = types.StringNull()
+	state.DestroyDescription = types.StringNull()
+	state.DestroyDeployedItem = tfvalues.NewNormalizedJSONNull()
+	state.WaitForState = types.ListNull(types.StringType)
+	state.Timeouts = changeInstanceTimeoutsNull()
+	resp.Diagnostics.Append(state.setChangeInstance(config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.ID = types.Int64Value(state.ID.ValueInt64())
-	state.State = types.StringValue(config.State)
-	state.POV = types.StringValue(pov)
-	state.DeployedItem = types.StringValue(string(deployedItemData))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -262,9 +681,195 @@ func changeInstanceImportFramework(id int64, pov string, client *netorca.NetOrca
 	var diags diag.Diagnostics
 
 	changeInstance, err := client.ChangeInstanceGetById(id, pov)
-	if err != nil {
+	if netorca.IsNotFound(err) {
+		diags.AddError(
+			"Change instance not found",
+			fmt.Sprintf("Change instance id: %d doesn't exist or isn't visible from the %s POV.", id, pov),
+		)
+	} else if err != nil {
 		diags.AddError(fmt.Sprintf("Error retrieving NetOrca change instance id: %d", id), err.Error())
 	}
 
 	return changeInstance, diags
 }
+
+// waitForState returns the change instance once it reaches one of the wait_for_state states, or straight away when
+// wait_for_state isn't set. The last change instance read is returned along with any error.
+func (c *changeInstancesResource) waitForState(ctx context.Context, m changeInstanceResourceModel, timeout time.Duration) (netorca.ChangeInstance, error) {
+	var states []string
+	if !m.WaitForState.IsNull() && !m.WaitForState.IsUnknown() {
+		if diags := m.WaitForState.ElementsAs(ctx, &states, false); diags.HasError() {
+			return netorca.ChangeInstance{}, fmt.Errorf("reading wait_for_state")
+		}
+	}
+
+	if len(states) == 0 {
+		return c.client.ChangeInstanceGetById(m.ChangeInstanceID.ValueInt64(), m.POV.ValueString())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return c.client.ChangeInstanceWait(ctx, m.ChangeInstanceID.ValueInt64(), m.POV.ValueString(), states)
+}
+
+// validateDestroyTransition checks on destroy that the change instance can be moved to destroy_state, so an impossible
+// transition is reported by terraform plan rather than part way through an apply.
+func (c *changeInstancesResource) validateDestroyTransition(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state changeInstanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OnDestroy.ValueString() != changeInstanceOnDestroySetState {
+		return
+	}
+
+	if err := netorca.ValidateChangeInstanceTransition(state.POV.ValueString(), state.CurrentState.ValueString(), state.DestroyState.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destroy_state"),
+			"Invalid destroy_state transition",
+			fmt.Sprintf("Change instance id: %d can't be moved to state %s on destroy: %s", state.ChangeInstanceID.ValueInt64(), state.DestroyState.ValueString(), err.Error()),
+		)
+	}
+}
+
+// validateDestroyConfig checks that destroy_state is set when on_destroy is set_state, along with a reason for a
+// failed state, and that the other destroy settings are only set when they are used.
+func (m changeInstanceResourceModel) validateDestroyConfig() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.OnDestroy.IsUnknown() || m.DestroyState.IsUnknown() || m.DestroyDescription.IsUnknown() || m.DestroyDeployedItem.IsUnknown() {
+		return diags
+	}
+
+	if m.OnDestroy.ValueString() != changeInstanceOnDestroySetState {
+		settings := []struct {
+			name  string
+			value attr.Value
+		}{
+			{"destroy_state", m.DestroyState},
+			{"destroy_description", m.DestroyDescription},
+			{"destroy_deployed_item", m.DestroyDeployedItem},
+		}
+		for _, v := range settings {
+			if !v.value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(v.name),
+					"Unused destroy setting",
+					fmt.Sprintf("%s is only used when on_destroy is %s.", v.name, changeInstanceOnDestroySetState),
+				)
+			}
+		}
+		return diags
+	}
+
+	if m.DestroyState.IsNull() {
+		diags.AddAttributeError(
+			path.Root("destroy_state"),
+			"Missing destroy_state",
+			fmt.Sprintf("destroy_state is required when on_destroy is %s.", changeInstanceOnDestroySetState),
+		)
+		return diags
+	}
+
+	if slices.Contains(netorca.ChangeInstanceFailedStates, m.DestroyState.ValueString()) && strings.TrimSpace(m.DestroyDescription.ValueString()) == "" {
+		diags.AddAttributeError(
+			path.Root("destroy_description"),
+			"Missing destroy_description",
+			fmt.Sprintf("A destroy_description giving the reason is required when moving a change instance to state %s on destroy.", m.DestroyState.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// description returns the configured description, or the default one when it isn't set.
+func (m changeInstanceResourceModel) description() string {
+	if m.Description.IsNull() || m.Description.IsUnknown() {
+		return changeInstanceDefaultDescription
+	}
+
+	return m.Description.ValueString()
+}
+
+// changeInstanceTimeoutsNull returns an unset timeouts block, for state that isn't built from a plan.
+func changeInstanceTimeoutsNull() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+		}),
+	}
+}
+
+// changeInstanceTerraformID returns the composite Terraform ID of a change instance.
+func changeInstanceTerraformID(pov string, id int64) string {
+	return fmt.Sprintf("%s/%d", pov, id)
+}
+
+// setChangeInstance populates the model from a change instance, the POV is kept as configured.
+func (m *changeInstanceResourceModel) setChangeInstance(changeInstance netorca.ChangeInstance) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A service item without a deployed_item is stored as an empty object, so generated configuration is valid.
+	deployedItem := changeInstance.ServiceItemField.DeployedItem
+	if deployedItem == nil {
+		deployedItem = map[string]interface{}{}
+	}
+
+	deployedItemData, err := json.Marshal(deployedItem)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling service_item.deployed_item.data from change instance id: %d", changeInstance.Id), err.Error())
+		return diags
+	}
+
+	deployedItemValue, d := tfvalues.DynamicFromStruct(deployedItem)
+	diags.Append(d...)
+
+	m.ID = types.StringValue(changeInstanceTerraformID(m.POV.ValueString(), changeInstance.Id))
+	m.ChangeInstanceID = types.Int64Value(changeInstance.Id)
+	m.State = types.StringValue(changeInstance.State)
+	m.CurrentState = types.StringValue(changeInstance.State)
+	m.LastDescription = types.StringValue(changeInstance.LastDescription())
+	m.ChangeType = types.StringValue(changeInstance.ChangeType)
+	m.ServiceItemID = types.Int64Value(changeInstance.ServiceItemField.Id)
+	m.RetiresServiceItem = types.BoolValue(changeInstance.ChangeType == netorca.ChangeTypeDelete)
+	m.DeployedItem = tfvalues.NewNormalizedJSONValue(string(deployedItemData))
+	m.DeployedItemValue = deployedItemValue
+
+	return diags
+}
+
+// setAppliedChangeInstance populates the model after a create or update. A planned state and deployed_item are kept as
+// is, as the change instance may already have moved on from them while waiting for wait_for_state. The deployed_item
+// NetOrca holds is still recorded in deployed_item_value, and is refreshed into deployed_item on the next read.
+func (m *changeInstanceResourceModel) setAppliedChangeInstance(changeInstance netorca.ChangeInstance) diag.Diagnostics {
+	planned := m.State
+	plannedDeployedItem := m.DeployedItem
+
+	diags := m.setChangeInstance(changeInstance)
+	if !planned.IsNull() && !planned.IsUnknown() {
+		m.State = planned
+	}
+	if !plannedDeployedItem.IsNull() && !plannedDeployedItem.IsUnknown() {
+		m.DeployedItem = plannedDeployedItem
+	}
+
+	return diags
+}
+
+// setRefreshedChangeInstance populates the model when refreshing. The previous state is kept when the change instance has
+// only progressed from it towards completion, so APPROVED to COMPLETED isn't reverted on the next apply. Any other
+// change, e.g. to REJECTED or ERROR, is refreshed into the state and shows as drift.
+func (m *changeInstanceResourceModel) setRefreshedChangeInstance(changeInstance netorca.ChangeInstance) diag.Diagnostics {
+	previous := m.State
+
+	diags := m.setChangeInstance(changeInstance)
+	if !previous.IsNull() && !previous.IsUnknown() && netorca.ChangeInstanceStateProgressed(previous.ValueString(), changeInstance.State) {
+		m.State = previous
+	}
+
+	return diags
+}
@@ -0,0 +1,603 @@
+// Copyright (c) HashiCorp, Inc.
+
+package resouces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serviceItemDefaultTimeout is how long to wait for a change instance to complete when no timeout is configured.
+const serviceItemDefaultTimeout = 30 * time.Minute
+
+// -----------------------------------------------------------------------------
+// Interface Assertions
+// -----------------------------------------------------------------------------
+
+var (
+	_ resource.Resource                   = (*serviceItemResource)(nil)
+	_ resource.ResourceWithImportState    = (*serviceItemResource)(nil)
+	_ resource.ResourceWithConfigure      = (*serviceItemResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*serviceItemResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*serviceItemResource)(nil)
+)
+
+// -----------------------------------------------------------------------------
+// Constructor and Type Definitions
+// -----------------------------------------------------------------------------
+
+// NewServiceItemResource returns a new instance of the serviceItemResource.
+func NewServiceItemResource() resource.Resource {
+	return &serviceItemResource{}
+}
+
+// serviceItemResource implements the resource.Resource interface.
+type serviceItemResource struct {
+	client *netorca.NetOrcaClient
+}
+
+// serviceItemResourceModel defines the schema model for the resource.
+type serviceItemResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	ServiceItemID       types.Int64    `tfsdk:"service_item_id"`
+	ApplicationID       types.Int64    `tfsdk:"application_id"`
+	ServiceName         types.String   `tfsdk:"service_name"`
+	Name                types.String   `tfsdk:"name"`
+	Declaration         types.String   `tfsdk:"declaration"`
+	CommitID            types.String   `tfsdk:"commit_id"`
+	WaitForCompletion   types.Bool     `tfsdk:"wait_for_completion"`
+	ChangeInstanceID    types.Int64    `tfsdk:"change_instance_id"`
+	ChangeInstanceState types.String   `tfsdk:"change_instance_state"`
+	DeployedItem        types.String   `tfsdk:"deployed_item"`
+	DeployedItemValue   types.Dynamic  `tfsdk:"deployed_item_value"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// -----------------------------------------------------------------------------
+// Resource Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the resource type name.
+func (r *serviceItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_item"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Declares a NetOrca service item from the consumer POV. Creating, updating and destroying the resource each submit the " +
+			"application with the service item added, changed or removed, which NetOrca turns into a CREATE, MODIFY or DELETE change instance. " +
+			"The other service items of the application are submitted as NetOrca will hold them once their pending change instances are applied, " +
+			"so pending deletions and changes submitted elsewhere are kept. " +
+			"Submissions of the same application are serialized within a Terraform run only. A submission made elsewhere while the application " +
+			"is read fails the change so it can be retried, but one made between that check and the submission can still be overwritten.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NetOrca service item ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_item_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The NetOrca service item ID.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the consumer application the service item belongs to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the service the service item is requested from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the service item, unique within the application and service. It is added to the declaration as `name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"declaration": schema.StringAttribute{
+				Required:    true,
+				Description: "A json object declaring the service item, as validated by the service's schema.",
+			},
+			"commit_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The commit id recorded against the submissions made by this resource.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Wait for the change instance of each submission to be COMPLETED, failing if it is REJECTED or reaches ERROR. Defaults to false.",
+			},
+			"change_instance_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the change instance created by the last submission that changed the service item.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"change_instance_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the change instance created by the last submission that changed the service item.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployed_item": schema.StringAttribute{
+				Computed:    true,
+				Description: "The deployed_item recorded by the service owner as a json string.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployed_item_value": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "The deployed_item recorded by the service owner, decoded into an object.",
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure sets the provider client on the resource.
+func (r *serviceItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig ensures declaration is a json object whose name, if set, matches the resource name.
+func (r *serviceItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceItemResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Declaration.IsNull() || config.Declaration.IsUnknown() {
+		return
+	}
+
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(config.Declaration.ValueString()), &declaration); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("declaration"),
+			"Invalid declaration",
+			fmt.Sprintf("declaration must be a json object: %s", err.Error()),
+		)
+		return
+	}
+
+	if name, ok := declaration["name"]; ok && !config.Name.IsUnknown() && name != config.Name.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("declaration"),
+			"Invalid declaration",
+			fmt.Sprintf("declaration name %v doesn't match the resource name %s, leave it out of the declaration or set it to the same value", name, config.Name.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan keeps the change instance and deployed_item values of the state in plans that don't change the declaration.
+// A changed declaration is submitted as a new change instance, so the values are only known after apply.
+func (r *serviceItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state serviceItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Declaration.Equal(state.Declaration) {
+		return
+	}
+
+	plan.ChangeInstanceID = types.Int64Unknown()
+	plan.ChangeInstanceState = types.StringUnknown()
+	plan.DeployedItem = types.StringUnknown()
+	plan.DeployedItemValue = types.DynamicUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create submits the application with the service item added.
+func (r *serviceItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, serviceItemDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declaration, err := plan.declaration()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading declaration", err.Error())
+		return
+	}
+
+	changeInstance, found, diags := r.editAndSubmit(plan, func(submission netorca.Submission, applicationName string) diag.Diagnostics {
+		var diags diag.Diagnostics
+		for _, v := range submission[applicationName].Services[plan.ServiceName.ValueString()] {
+			if v["name"] == plan.Name.ValueString() {
+				diags.AddError(
+					"Service item already exists",
+					fmt.Sprintf("Application %s already declares %s service item %s, import it instead.", applicationName, plan.ServiceName.ValueString(), plan.Name.ValueString()),
+				)
+				return diags
+			}
+		}
+
+		submission.SetServiceItem(applicationName, plan.ServiceName.ValueString(), plan.Name.ValueString(), declaration)
+		return diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error submitting service item %s", plan.Name.ValueString()),
+			"NetOrca accepted the submission but didn't create a change instance for the service item.",
+		)
+		return
+	}
+
+	plan.ServiceItemID = types.Int64Value(changeInstance.ServiceItemField.Id)
+	plan.ID = types.StringValue(strconv.FormatInt(changeInstance.ServiceItemField.Id, 10))
+
+	resp.Diagnostics.Append(r.waitAndRefresh(ctx, &plan, changeInstance, createTimeout)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read retrieves the service item, removing it from state when it no longer exists.
+func (r *serviceItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceItem, err := r.client.ServiceItemGetById(state.ServiceItemID.ValueInt64(), netorca.PovConsumer)
+	if netorca.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting service item id: %d", state.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	if state.ChangeInstanceID.ValueInt64() != 0 {
+		changeInstance, err := r.client.ChangeInstanceGetById(state.ChangeInstanceID.ValueInt64(), netorca.PovConsumer)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting change instance id: %d", state.ChangeInstanceID.ValueInt64()), err.Error())
+			return
+		}
+		state.ChangeInstanceState = types.StringValue(changeInstance.State)
+	}
+
+	resp.Diagnostics.Append(state.setServiceItem(serviceItem)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update submits the application with the service item's changed declaration.
+func (r *serviceItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serviceItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, serviceItemDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declaration, err := plan.declaration()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading declaration", err.Error())
+		return
+	}
+
+	changeInstance, found, diags := r.editAndSubmit(plan, func(submission netorca.Submission, applicationName string) diag.Diagnostics {
+		submission.SetServiceItem(applicationName, plan.ServiceName.ValueString(), plan.Name.ValueString(), declaration)
+		return nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A submission that doesn't change the declaration creates no change instance, the previous one is kept.
+	if !found {
+		changeInstance = netorca.ChangeInstance{Id: state.ChangeInstanceID.ValueInt64()}
+	}
+
+	resp.Diagnostics.Append(r.waitAndRefresh(ctx, &plan, changeInstance, updateTimeout)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete submits the application without the service item.
+func (r *serviceItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, serviceItemDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changeInstance, found, diags := r.editAndSubmit(state, func(submission netorca.Submission, applicationName string) diag.Diagnostics {
+		submission.RemoveServiceItem(applicationName, state.ServiceName.ValueString(), state.Name.ValueString())
+		return nil
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found || !state.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.ChangeInstanceWait(ctx, changeInstance.Id, netorca.PovConsumer, []string{netorca.ChangeInstanceStateCompleted})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for removal of service item %s", state.Name.ValueString()), err.Error())
+	}
+}
+
+// ImportState imports an existing service item by its ID.
+func (r *serviceItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error parsing NetOrca service item ID from terraform ID: %s", req.ID),
+			"Expected the import ID to be a numeric service item ID, e.g. 123.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_item_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), false)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Functions
+// -----------------------------------------------------------------------------
+
+// editAndSubmit reads the current submission of the application, lets edit change it and submits it. The application is
+// locked from the read until the submission is made, since sibling service items are applied in parallel and each
+// submission replaces the whole application. The lock only covers this provider process, submissions made elsewhere
+// while the application is read are detected by its latest submission changing, and fail the change.
+func (r *serviceItemResource) editAndSubmit(m serviceItemResourceModel, edit func(submission netorca.Submission, applicationName string) diag.Diagnostics) (netorca.ChangeInstance, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	applicationId := m.ApplicationID.ValueInt64()
+
+	unlock := r.client.LockApplication(applicationId)
+	defer unlock()
+
+	latestSubmissionId, err := r.client.ApplicationLatestSubmissionId(applicationId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error getting the latest submission of application id: %d", applicationId), err.Error())
+		return netorca.ChangeInstance{}, false, diags
+	}
+
+	submission, applicationName, err := r.client.ApplicationSubmission(applicationId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error getting the service items of application id: %d", applicationId), err.Error())
+		return netorca.ChangeInstance{}, false, diags
+	}
+
+	diags.Append(edit(submission, applicationName)...)
+	if diags.HasError() {
+		return netorca.ChangeInstance{}, false, diags
+	}
+
+	currentSubmissionId, err := r.client.ApplicationLatestSubmissionId(applicationId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error getting the latest submission of application id: %d", applicationId), err.Error())
+		return netorca.ChangeInstance{}, false, diags
+	}
+	if currentSubmissionId != latestSubmissionId {
+		diags.AddError(
+			fmt.Sprintf("Application %s was submitted concurrently", applicationName),
+			fmt.Sprintf("Submission id: %d was made for application id: %d while service item %s was being changed, "+
+				"so the application may have changed since it was read. Retry the apply.", currentSubmissionId, applicationId, m.Name.ValueString()),
+		)
+		return netorca.ChangeInstance{}, false, diags
+	}
+
+	changeInstance, found, err := r.submit(submission, m)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error submitting service item %s", m.Name.ValueString()), err.Error())
+		return netorca.ChangeInstance{}, false, diags
+	}
+
+	return changeInstance, found, diags
+}
+
+// submit sends a partial submission of the application and returns the change instance it created for the service item.
+func (r *serviceItemResource) submit(submission netorca.Submission, m serviceItemResourceModel) (netorca.ChangeInstance, bool, error) {
+	submissionResponse, err := r.client.SubmissionSubmit(submission, m.CommitID.ValueString(), true)
+	if err != nil {
+		return netorca.ChangeInstance{}, false, err
+	}
+
+	return r.client.SubmissionChangeInstance(submissionResponse.Id, m.ServiceName.ValueString(), m.Name.ValueString())
+}
+
+// waitAndRefresh optionally waits for the change instance to complete and then populates the model from the service item.
+// The planned declaration is kept, NetOrca only holds it once the change instance is applied.
+func (r *serviceItemResource) waitAndRefresh(ctx context.Context, m *serviceItemResourceModel, changeInstance netorca.ChangeInstance, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ChangeInstanceID = types.Int64Value(changeInstance.Id)
+	m.ChangeInstanceState = types.StringValue(changeInstance.State)
+
+	if changeInstance.Id != 0 && m.WaitForCompletion.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		completed, err := r.client.ChangeInstanceWait(waitCtx, changeInstance.Id, netorca.PovConsumer, []string{netorca.ChangeInstanceStateCompleted})
+		m.ChangeInstanceState = types.StringValue(completed.State)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error waiting for service item %s", m.Name.ValueString()), err.Error())
+		}
+	} else if changeInstance.Id != 0 && changeInstance.State == "" {
+		current, err := r.client.ChangeInstanceGetById(changeInstance.Id, netorca.PovConsumer)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error getting change instance id: %d", changeInstance.Id), err.Error())
+			return diags
+		}
+		m.ChangeInstanceState = types.StringValue(current.State)
+	}
+
+	serviceItem, err := r.client.ServiceItemGetById(m.ServiceItemID.ValueInt64(), netorca.PovConsumer)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error getting service item id: %d", m.ServiceItemID.ValueInt64()), err.Error())
+		m.DeployedItem = types.StringNull()
+		m.DeployedItemValue = types.DynamicNull()
+		return diags
+	}
+
+	planned := m.Declaration
+	diags.Append(m.setServiceItem(serviceItem)...)
+	m.Declaration = planned
+
+	return diags
+}
+
+// declaration returns the configured declaration with the resource name added.
+func (m *serviceItemResourceModel) declaration() (map[string]interface{}, error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(m.Declaration.ValueString()), &declaration); err != nil {
+		return nil, fmt.Errorf("declaration must be a json object: %s", err.Error())
+	}
+	if declaration == nil {
+		declaration = map[string]interface{}{}
+	}
+
+	declaration["name"] = m.Name.ValueString()
+	return declaration, nil
+}
+
+// setServiceItem populates the model from a service item. The configured declaration string is kept when NetOrca holds
+// the same content once the name is added, so that formatting differences don't show as drift.
+func (m *serviceItemResourceModel) setServiceItem(serviceItem netorca.ServiceItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	remoteDeclaration, err := json.Marshal(serviceItem.Declaration)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling declaration from service item id: %d", serviceItem.Id), err.Error())
+		return diags
+	}
+
+	equal := false
+	if !m.Declaration.IsNull() && !m.Declaration.IsUnknown() {
+		if declaration, err := m.declaration(); err == nil {
+			if configured, err := json.Marshal(declaration); err == nil {
+				equal, _ = tfvalues.JSONSemanticallyEqual(string(configured), string(remoteDeclaration))
+			}
+		}
+	}
+	if !equal {
+		m.Declaration = types.StringValue(string(remoteDeclaration))
+	}
+
+	deployedItem, err := json.Marshal(serviceItem.DeployedItem)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling deployed_item from service item id: %d", serviceItem.Id), err.Error())
+		return diags
+	}
+
+	deployedItemValue, d := tfvalues.DynamicFromStruct(serviceItem.DeployedItem)
+	diags.Append(d...)
+
+	serviceName := serviceItem.ServiceName
+	if serviceName == "" {
+		serviceName = serviceItem.Service.Name
+	}
+
+	m.ID = types.StringValue(strconv.FormatInt(serviceItem.Id, 10))
+	m.ServiceItemID = types.Int64Value(serviceItem.Id)
+	m.ApplicationID = types.Int64Value(serviceItem.Application.Id)
+	m.ServiceName = types.StringValue(serviceName)
+	m.Name = types.StringValue(serviceItem.Name)
+	m.DeployedItem = types.StringValue(string(deployedItem))
+	m.DeployedItemValue = deployedItemValue
+
+	return diags
+}
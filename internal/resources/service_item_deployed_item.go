@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+
+package resouces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Interface Assertions
+// -----------------------------------------------------------------------------
+
+var (
+	_ resource.Resource                   = (*serviceItemDeployedItemResource)(nil)
+	_ resource.ResourceWithImportState    = (*serviceItemDeployedItemResource)(nil)
+	_ resource.ResourceWithConfigure      = (*serviceItemDeployedItemResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*serviceItemDeployedItemResource)(nil)
+)
+
+// -----------------------------------------------------------------------------
+// Constructor and Type Definitions
+// -----------------------------------------------------------------------------
+
+// NewServiceItemDeployedItemResource returns a new instance of the serviceItemDeployedItemResource.
+func NewServiceItemDeployedItemResource() resource.Resource {
+	return &serviceItemDeployedItemResource{}
+}
+
+// serviceItemDeployedItemResource implements the resource.Resource interface.
+type serviceItemDeployedItemResource struct {
+	client *netorca.NetOrcaClient
+}
+
+// serviceItemDeployedItemResourceModel defines the schema model for the resource.
+type serviceItemDeployedItemResourceModel struct {
+	ID                types.String            `tfsdk:"id"`
+	ServiceItemID     types.Int64             `tfsdk:"service_item_id"`
+	DeployedItem      tfvalues.NormalizedJSON `tfsdk:"deployed_item"`
+	DeployedItemValue types.Dynamic           `tfsdk:"deployed_item_value"`
+	Version           types.Int64             `tfsdk:"version"`
+}
+
+// -----------------------------------------------------------------------------
+// Resource Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the resource type name.
+func (r *serviceItemDeployedItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_item_deployed_item"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceItemDeployedItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the deployed_item of a NetOrca service item directly from the serviceowner POV, outside of a change instance. " +
+			"Changes made to the deployed_item outside of Terraform are detected and reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NetOrca service item ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_item_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the service item whose deployed_item is managed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"deployed_item": schema.StringAttribute{
+				CustomType:  tfvalues.NormalizedJSONType{},
+				Required:    true,
+				Description: "A json object to set as the deployed_item of the service item.",
+			},
+			"deployed_item_value": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "The deployed_item as recorded by NetOrca, decoded into an object.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version NetOrca recorded for the deployed_item, incremented on every write.",
+			},
+		},
+	}
+}
+
+// Configure sets the provider client on the resource.
+func (r *serviceItemDeployedItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig ensures deployed_item is a json object before any request is made.
+func (r *serviceItemDeployedItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceItemDeployedItemResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DeployedItem.IsNull() || config.DeployedItem.IsUnknown() {
+		return
+	}
+
+	var deployedItem map[string]interface{}
+	if err := json.Unmarshal([]byte(config.DeployedItem.ValueString()), &deployedItem); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deployed_item"),
+			"Invalid deployed_item",
+			fmt.Sprintf("deployed_item must be a json object: %s", err.Error()),
+		)
+	}
+}
+
+// Create writes the deployed_item to the service item and then refreshes the state.
+func (r *serviceItemDeployedItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceItemDeployedItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := netorca.ServiceItemUpdateRequest{
+		DeployedItem: plan.DeployedItem.ValueString(),
+	}
+
+	serviceItem, err := r.client.ServiceItemPatch(plan.ServiceItemID.ValueInt64(), netorca.PovServiceOwner, content)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating deployed_item of service item id: %d", plan.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setServiceItem(serviceItem)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read retrieves the deployed_item currently recorded by NetOrca, surfacing any drift from the configuration.
+func (r *serviceItemDeployedItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceItemDeployedItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceItem, err := r.client.ServiceItemGetById(state.ServiceItemID.ValueInt64(), netorca.PovServiceOwner)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting service item id: %d", state.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setServiceItem(serviceItem)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update writes the changed deployed_item to the service item.
+func (r *serviceItemDeployedItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceItemDeployedItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := netorca.ServiceItemUpdateRequest{
+		DeployedItem: plan.DeployedItem.ValueString(),
+	}
+
+	serviceItem, err := r.client.ServiceItemPatch(plan.ServiceItemID.ValueInt64(), netorca.PovServiceOwner, content)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating deployed_item of service item id: %d", plan.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setServiceItem(serviceItem)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete only removes the resource from state, the deployed_item is left as is on the service item.
+func (r *serviceItemDeployedItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceItemDeployedItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing deployed_item of service item id: %d from state, NetOrca is left unchanged", state.ServiceItemID.ValueInt64()))
+}
+
+// ImportState imports the deployed_item of an existing service item by its ID.
+func (r *serviceItemDeployedItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error parsing NetOrca service item ID from terraform ID: %s", req.ID),
+			"Expected the import ID to be a numeric service item ID, e.g. 123.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_item_id"), id)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Functions
+// -----------------------------------------------------------------------------
+
+// setServiceItem populates the model from a service item. Only the data of the deployed_item NetOrca returns is kept,
+// its version is set separately.
+func (m *serviceItemDeployedItemResourceModel) setServiceItem(serviceItem netorca.ServiceItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A service item without a deployed_item is stored as an empty object, so generated configuration is valid.
+	deployedItem := serviceItem.DeployedItemData()
+	if deployedItem == nil {
+		deployedItem = map[string]interface{}{}
+	}
+
+	deployedItemData, err := json.Marshal(deployedItem)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error marshalling deployed_item from service item id: %d", serviceItem.Id), err.Error())
+		return diags
+	}
+
+	deployedItemValue, d := tfvalues.DynamicFromStruct(deployedItem)
+	diags.Append(d...)
+
+	m.ID = types.StringValue(strconv.FormatInt(serviceItem.Id, 10))
+	m.ServiceItemID = types.Int64Value(serviceItem.Id)
+	m.DeployedItem = tfvalues.NewNormalizedJSONValue(string(deployedItemData))
+	m.DeployedItemValue = deployedItemValue
+	m.Version = types.Int64Value(serviceItem.DeployedItemVersion())
+
+	return diags
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+
+package resouces
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-netorca/internal/netorca"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// -----------------------------------------------------------------------------
+// Interface Assertions
+// -----------------------------------------------------------------------------
+
+var (
+	_ resource.Resource                = (*serviceItemRuntimeStateResource)(nil)
+	_ resource.ResourceWithImportState = (*serviceItemRuntimeStateResource)(nil)
+	_ resource.ResourceWithConfigure   = (*serviceItemRuntimeStateResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*serviceItemRuntimeStateResource)(nil)
+)
+
+// -----------------------------------------------------------------------------
+// Constructor and Type Definitions
+// -----------------------------------------------------------------------------
+
+// NewServiceItemRuntimeStateResource returns a new instance of the serviceItemRuntimeStateResource.
+func NewServiceItemRuntimeStateResource() resource.Resource {
+	return &serviceItemRuntimeStateResource{}
+}
+
+// serviceItemRuntimeStateResource implements the resource.Resource interface.
+type serviceItemRuntimeStateResource struct {
+	client *netorca.NetOrcaClient
+}
+
+// serviceItemRuntimeStateResourceModel defines the schema model for the resource.
+type serviceItemRuntimeStateResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ServiceItemID types.Int64  `tfsdk:"service_item_id"`
+	RuntimeState  types.String `tfsdk:"runtime_state"`
+}
+
+// -----------------------------------------------------------------------------
+// Resource Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the resource type name.
+func (r *serviceItemRuntimeStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_item_runtime_state"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceItemRuntimeStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the runtime_state of a NetOrca service item from the serviceowner POV, e.g. to take an item out of service for maintenance or decommissioning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NetOrca service item ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_item_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the service item whose runtime_state is managed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_state": schema.StringAttribute{
+				Required:    true,
+				Description: "The runtime state of the service item e.g. IN_SERVICE|OUT_OF_SERVICE",
+				Validators: []validator.String{
+					stringvalidator.OneOf(netorca.RuntimeStates...),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client on the resource.
+func (r *serviceItemRuntimeStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan validates the planned runtime_state transition against the service item's current runtime_state.
+func (r *serviceItemRuntimeStateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan serviceItemRuntimeStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RuntimeState.IsUnknown() || plan.ServiceItemID.IsUnknown() {
+		return
+	}
+
+	var state serviceItemRuntimeStateResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The refreshed state is used where possible, a new or replaced service item is looked up instead.
+	var current string
+	if !req.State.Raw.IsNull() && state.ServiceItemID.Equal(plan.ServiceItemID) {
+		current = state.RuntimeState.ValueString()
+	} else {
+		if r.client == nil {
+			return
+		}
+		serviceItem, err := r.client.ServiceItemGetById(plan.ServiceItemID.ValueInt64(), netorca.PovServiceOwner)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error getting service item id: %d", plan.ServiceItemID.ValueInt64()), err.Error())
+			return
+		}
+		current = serviceItem.RuntimeState
+	}
+
+	if err := netorca.ValidateRuntimeStateTransition(current, plan.RuntimeState.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("runtime_state"),
+			"Invalid runtime_state transition",
+			fmt.Sprintf("Service item id: %d can't be moved to runtime_state %s: %s", plan.ServiceItemID.ValueInt64(), plan.RuntimeState.ValueString(), err.Error()),
+		)
+	}
+}
+
+// Create moves the service item to the configured runtime_state and then refreshes the state.
+func (r *serviceItemRuntimeStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceItemRuntimeStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceItem, err := r.setRuntimeState(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating runtime_state of service item id: %d", plan.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	plan.setServiceItem(serviceItem)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read retrieves the runtime_state currently recorded by NetOrca.
+func (r *serviceItemRuntimeStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceItemRuntimeStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceItem, err := r.client.ServiceItemGetById(state.ServiceItemID.ValueInt64(), netorca.PovServiceOwner)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting service item id: %d", state.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	state.setServiceItem(serviceItem)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves the service item to the changed runtime_state.
+func (r *serviceItemRuntimeStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceItemRuntimeStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceItem, err := r.setRuntimeState(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating runtime_state of service item id: %d", plan.ServiceItemID.ValueInt64()), err.Error())
+		return
+	}
+
+	plan.setServiceItem(serviceItem)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete only removes the resource from state, the service item keeps its current runtime_state.
+func (r *serviceItemRuntimeStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceItemRuntimeStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing runtime_state of service item id: %d from state, NetOrca is left unchanged", state.ServiceItemID.ValueInt64()))
+}
+
+// ImportState imports the runtime_state of an existing service item by its ID.
+func (r *serviceItemRuntimeStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error parsing NetOrca service item ID from terraform ID: %s", req.ID),
+			"Expected the import ID to be a numeric service item ID, e.g. 123.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_item_id"), id)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Functions
+// -----------------------------------------------------------------------------
+
+// setRuntimeState patches the service item with the planned runtime_state.
+func (r *serviceItemRuntimeStateResource) setRuntimeState(plan serviceItemRuntimeStateResourceModel) (netorca.ServiceItem, error) {
+	content := netorca.ServiceItemUpdateRequest{
+		RuntimeState: plan.RuntimeState.ValueString(),
+	}
+
+	return r.client.ServiceItemPatch(plan.ServiceItemID.ValueInt64(), netorca.PovServiceOwner, content)
+}
+
+// setServiceItem populates the model from a service item.
+func (m *serviceItemRuntimeStateResourceModel) setServiceItem(serviceItem netorca.ServiceItem) {
+	m.ID = types.StringValue(strconv.FormatInt(serviceItem.Id, 10))
+	m.ServiceItemID = types.Int64Value(serviceItem.Id)
+	m.RuntimeState = types.StringValue(serviceItem.RuntimeState)
+}
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+
+package resouces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-netorca/internal/netorca"
+	"terraform-provider-netorca/internal/tfvalues"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// -----------------------------------------------------------------------------
+// Interface Assertions
+// -----------------------------------------------------------------------------
+
+var (
+	_ resource.Resource                   = (*submissionResource)(nil)
+	_ resource.ResourceWithConfigure      = (*submissionResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*submissionResource)(nil)
+)
+
+// -----------------------------------------------------------------------------
+// Constructor and Type Definitions
+// -----------------------------------------------------------------------------
+
+// NewSubmissionResource returns a new instance of the submissionResource.
+func NewSubmissionResource() resource.Resource {
+	return &submissionResource{}
+}
+
+// submissionResource implements the resource.Resource interface.
+type submissionResource struct {
+	client *netorca.NetOrcaClient
+}
+
+// submissionResourceModel defines the schema model for the resource.
+type submissionResourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	SubmissionID    types.Int64             `tfsdk:"submission_id"`
+	Declaration     tfvalues.NormalizedJSON `tfsdk:"declaration"`
+	CommitID        types.String            `tfsdk:"commit_id"`
+	Partial         types.Bool              `tfsdk:"partial"`
+	ChangeInstances types.List              `tfsdk:"change_instances"`
+}
+
+// -----------------------------------------------------------------------------
+// Resource Interface Methods
+// -----------------------------------------------------------------------------
+
+// Metadata sets the resource type name.
+func (r *submissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submission"
+}
+
+// Schema defines the schema for the resource.
+func (r *submissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Submits a consumer declaration of applications to NetOrca, which turns every added, changed or removed service item into a change instance. " +
+			"The declaration is submitted on create and again whenever it, commit_id or partial change. " +
+			"Destroying the resource only removes it from state, the declared service items are left as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the last submission.",
+			},
+			"submission_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the last submission.",
+			},
+			"declaration": schema.StringAttribute{
+				Required:   true,
+				CustomType: tfvalues.NormalizedJSONType{},
+				Description: "A json object declaring applications keyed by name, each with its metadata and its service items' declarations " +
+					"keyed by service name, i.e. {\"app\": {\"metadata\": {}, \"services\": {\"service\": [{\"name\": \"item\"}]}}}.",
+			},
+			"commit_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The commit id recorded against the submission, e.g. the git commit of the declaration.",
+			},
+			"partial": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "Only change the applications in the declaration, leaving the team's other applications as they are. Defaults to true. " +
+					"When false the declaration is submitted as the team's full state, so every service item of the team's applications that " +
+					"isn't declared, including those of applications missing from the declaration, is deleted.",
+			},
+			"change_instances": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The change instances created by the last submission, empty when it didn't change any service item.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"state": schema.StringAttribute{
+							Computed: true,
+						},
+						"change_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of change (CREATE|MODIFY|DELETE).",
+						},
+						"application_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_item_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"service_item_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client on the resource.
+func (r *submissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*netorca.NetOrcaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *NetOrca.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig ensures declaration has the shape of a submission.
+func (r *submissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config submissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Declaration.IsNull() || config.Declaration.IsUnknown() {
+		return
+	}
+
+	if _, err := config.submission(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("declaration"), "Invalid declaration", err.Error())
+	}
+}
+
+// Create submits the declaration.
+func (r *submissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan submissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.submit(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the change instances created by the last submission.
+func (r *submissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state submissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(&state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update submits the changed declaration.
+func (r *submissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan submissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.submit(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete is a no-op since submissions can't be withdrawn, the declared service items are left as they are.
+func (r *submissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state submissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+}
+
+// -----------------------------------------------------------------------------
+// Helper Functions
+// -----------------------------------------------------------------------------
+
+// submit sends the declaration and records the submission and the change instances it created in the model. NetOrca's
+// validation errors are reported against declaration, one diagnostic per error.
+func (r *submissionResource) submit(m *submissionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	submission, err := m.submission()
+	if err != nil {
+		diags.AddAttributeError(path.Root("declaration"), "Invalid declaration", err.Error())
+		return diags
+	}
+
+	submissionResponse, err := r.client.SubmissionSubmit(submission, m.CommitID.ValueString(), m.Partial.ValueBool())
+	var validationErr *netorca.SubmissionValidationError
+	if errors.As(err, &validationErr) {
+		for _, v := range validationErr.Errors {
+			diags.AddAttributeError(path.Root("declaration"), "Invalid submission", submissionErrorDetail(v))
+		}
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error submitting declaration", err.Error())
+		return diags
+	}
+
+	m.SubmissionID = types.Int64Value(submissionResponse.Id)
+	m.ID = types.StringValue(strconv.FormatInt(submissionResponse.Id, 10))
+
+	diags.Append(r.refresh(m)...)
+	return diags
+}
+
+// refresh populates the change instances of the model from NetOrca.
+func (r *submissionResource) refresh(m *submissionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	changeInstances, err := r.client.ChangeInstanceGetAll(&netorca.ChangeInstanceQuery{Pov: netorca.PovConsumer, SubmissionId: m.SubmissionID.ValueInt64()})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error getting the change instances of submission id: %d", m.SubmissionID.ValueInt64()), err.Error())
+		return diags
+	}
+
+	list, d := getTerraformSubmissionChangeInstances(changeInstances)
+	diags.Append(d...)
+	m.ChangeInstances = list
+
+	return diags
+}
+
+// submission decodes the configured declaration.
+func (m *submissionResourceModel) submission() (netorca.Submission, error) {
+	return netorca.ParseSubmission(m.Declaration.ValueString())
+}
+
+// submissionErrorDetail describes a NetOrca validation error, prefixed by the path of the invalid value.
+func submissionErrorDetail(e netorca.SubmissionError) string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(e.Path, "."), e.Message)
+}
+
+// getTerraformSubmissionChangeInstances converts the change instances of a submission into a Terraform list.
+func getTerraformSubmissionChangeInstances(changeInstances []netorca.ChangeInstance) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elems := []attr.Value{}
+
+	for _, v := range changeInstances {
+		serviceName := v.ServiceItemField.ServiceName
+		if serviceName == "" {
+			serviceName = v.ServiceItemField.Service.Name
+		}
+
+		obj, d := types.ObjectValue(submissionChangeInstanceAttrTypes, map[string]attr.Value{
+			"id":                types.Int64Value(v.Id),
+			"state":             types.StringValue(v.State),
+			"change_type":       types.StringValue(v.ChangeType),
+			"application_name":  types.StringValue(v.ServiceItemField.Application.Name),
+			"service_name":      types.StringValue(serviceName),
+			"service_item_id":   types.Int64Value(v.ServiceItemField.Id),
+			"service_item_name": types.StringValue(v.ServiceItemField.Name),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: submissionChangeInstanceAttrTypes}, elems)
+	diags.Append(d...)
+
+	return list, diags
+}
+
+// -----------------------------------------------------------------------------
+// Global Attribute Type Definitions
+// -----------------------------------------------------------------------------
+
+var submissionChangeInstanceAttrTypes = map[string]attr.Type{
+	"id":                types.Int64Type,
+	"state":             types.StringType,
+	"change_type":       types.StringType,
+	"application_name":  types.StringType,
+	"service_name":      types.StringType,
+	"service_item_id":   types.Int64Type,
+	"service_item_name": types.StringType,
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+
+package tfvalues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DynamicFromJSON converts a decoded json value (as produced by encoding/json) into a Terraform dynamic value.
+// Objects become Terraform objects, arrays become tuples so that each element may have its own type.
+func DynamicFromJSON(v interface{}) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v == nil {
+		return types.DynamicNull(), diags
+	}
+
+	value, err := ValueFromJSON(v)
+	if err != nil {
+		diags.AddError("Error converting json value into a dynamic value", err.Error())
+		return types.DynamicNull(), diags
+	}
+
+	return types.DynamicValue(value), diags
+}
+
+// DynamicFromStruct marshals any json serialisable value (e.g. a netorca.ServiceItem) and converts the result
+// into a Terraform dynamic value keyed by the json field names.
+func DynamicFromStruct(v interface{}) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		diags.AddError("Error marshalling value into json", err.Error())
+		return types.DynamicNull(), diags
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		diags.AddError("Error unmarshalling json value", err.Error())
+		return types.DynamicNull(), diags
+	}
+
+	return DynamicFromJSON(decoded)
+}
+
+// ValueFromJSON converts a decoded json value into the equivalent attr.Value.
+// Json nulls nested inside objects or arrays are represented as null strings, as Terraform requires a concrete type.
+func ValueFromJSON(v interface{}) (attr.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(val), nil
+	case string:
+		return types.StringValue(val), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(val)), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(val.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse number %q: %w", val.String(), err)
+		}
+		return types.NumberValue(f), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(val))
+		elems := make([]attr.Value, 0, len(val))
+		for i, e := range val {
+			elem, err := ValueFromJSON(e)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to build tuple: %v", diags)
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(val))
+		attrs := make(map[string]attr.Value, len(val))
+		for k, e := range val {
+			elem, err := ValueFromJSON(e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k] = elem.Type(context.Background())
+			attrs[k] = elem
+		}
+		obj, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to build object: %v", diags)
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unsupported json type %T", v)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+
+package tfvalues
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDynamicFromJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     interface{}
+		expected types.Dynamic
+	}{
+		{
+			name:     "null",
+			args:     nil,
+			expected: types.DynamicNull(),
+		},
+		{
+			name:     "string",
+			args:     "example.com",
+			expected: types.DynamicValue(types.StringValue("example.com")),
+		},
+		{
+			name: "object",
+			args: map[string]interface{}{
+				"zone":    "example.com",
+				"ttl":     float64(300),
+				"enabled": true,
+				"owner":   nil,
+			},
+			expected: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"zone":    types.StringType,
+					"ttl":     types.NumberType,
+					"enabled": types.BoolType,
+					"owner":   types.StringType,
+				},
+				map[string]attr.Value{
+					"zone":    types.StringValue("example.com"),
+					"ttl":     types.NumberValue(big.NewFloat(300)),
+					"enabled": types.BoolValue(true),
+					"owner":   types.StringNull(),
+				},
+			)),
+		},
+		{
+			name: "mixed_array",
+			args: []interface{}{"10.0.0.1", float64(1)},
+			expected: types.DynamicValue(types.TupleValueMust(
+				[]attr.Type{types.StringType, types.NumberType},
+				[]attr.Value{types.StringValue("10.0.0.1"), types.NumberValue(big.NewFloat(1))},
+			)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, diags := DynamicFromJSON(test.args)
+			if diags.HasError() {
+				t.Fatalf("Expected no error, got %v", diags)
+			}
+
+			if !result.Equal(test.expected) {
+				t.Errorf("Expected: %v, Got: %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestDynamicFromStruct(t *testing.T) {
+	type item struct {
+		Id          int64                  `json:"id"`
+		Declaration map[string]interface{} `json:"declaration"`
+	}
+
+	result, diags := DynamicFromStruct(item{
+		Id:          9007199254740993,
+		Declaration: map[string]interface{}{"name": "vip1"},
+	})
+	if diags.HasError() {
+		t.Fatalf("Expected no error, got %v", diags)
+	}
+
+	id, _ := new(big.Float).SetPrec(512).SetString("9007199254740993")
+	expected := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"id":          types.NumberType,
+			"declaration": types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}},
+		},
+		map[string]attr.Value{
+			"id": types.NumberValue(id),
+			"declaration": types.ObjectValueMust(
+				map[string]attr.Type{"name": types.StringType},
+				map[string]attr.Value{"name": types.StringValue("vip1")},
+			),
+		},
+	))
+
+	if !result.Equal(expected) {
+		t.Errorf("Expected: %v, Got: %v", expected, result)
+	}
+}
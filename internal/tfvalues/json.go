@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+
+package tfvalues
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JSONSemanticallyEqual reports whether two json documents hold the same content,
+// ignoring whitespace and object key ordering.
+func JSONSemanticallyEqual(a, b string) (bool, error) {
+	var aDecoded, bDecoded interface{}
+
+	if err := json.Unmarshal([]byte(a), &aDecoded); err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal([]byte(b), &bDecoded); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(aDecoded, bDecoded), nil
+}
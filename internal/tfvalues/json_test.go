@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+
+package tfvalues
+
+import (
+	"testing"
+)
+
+func TestJSONSemanticallyEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+		err      bool
+	}{
+		{
+			name:     "identical",
+			a:        `{"ip":"10.0.0.1"}`,
+			b:        `{"ip":"10.0.0.1"}`,
+			expected: true,
+		},
+		{
+			name:     "whitespace_and_key_order",
+			a:        `{"ip":"10.0.0.1","deployed":true}`,
+			b:        "{\n  \"deployed\": true,\n  \"ip\": \"10.0.0.1\"\n}",
+			expected: true,
+		},
+		{
+			name:     "number_formatting",
+			a:        `{"ttl":300}`,
+			b:        `{"ttl":3.0e2}`,
+			expected: true,
+		},
+		{
+			name:     "different_content",
+			a:        `{"ip":"10.0.0.1"}`,
+			b:        `{"ip":"10.0.0.2"}`,
+			expected: false,
+		},
+		{
+			name: "invalid_json",
+			a:    `{"ip":"10.0.0.1"}`,
+			b:    `not json`,
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := JSONSemanticallyEqual(test.a, test.b)
+
+			if test.err {
+				if err == nil {
+					t.Fatalf("Expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if result != test.expected {
+				t.Errorf("Expected: %v, Got: %v", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+
+package tfvalues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = NormalizedJSON{}
+	_ xattr.ValidateableAttribute                = NormalizedJSON{}
+)
+
+// NormalizedJSONType is a string attribute type holding a json document. Values that hold the same content are
+// semantically equal, so whitespace, key order and number formatting don't show as a diff.
+type NormalizedJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable name of the type.
+func (t NormalizedJSONType) String() string {
+	return "tfvalues.NormalizedJSONType"
+}
+
+// ValueType returns the value type of NormalizedJSONType.
+func (t NormalizedJSONType) ValueType(ctx context.Context) attr.Value {
+	return NormalizedJSON{}
+}
+
+// Equal reports whether o is also a NormalizedJSONType.
+func (t NormalizedJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedJSONType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString wraps a string value as a NormalizedJSON.
+func (t NormalizedJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NormalizedJSON{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value into a NormalizedJSON.
+func (t NormalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return NormalizedJSON{StringValue: stringValue}, nil
+}
+
+// NormalizedJSON is a json document value of NormalizedJSONType.
+type NormalizedJSON struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedJSONNull returns a null NormalizedJSON.
+func NewNormalizedJSONNull() NormalizedJSON {
+	return NormalizedJSON{StringValue: basetypes.NewStringNull()}
+}
+
+// NewNormalizedJSONUnknown returns an unknown NormalizedJSON.
+func NewNormalizedJSONUnknown() NormalizedJSON {
+	return NormalizedJSON{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewNormalizedJSONValue returns a known NormalizedJSON holding the json document value.
+func NewNormalizedJSONValue(value string) NormalizedJSON {
+	return NormalizedJSON{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns a NormalizedJSONType.
+func (v NormalizedJSON) Type(ctx context.Context) attr.Type {
+	return NormalizedJSONType{}
+}
+
+// Equal reports whether o is a NormalizedJSON with exactly the same string.
+func (v NormalizedJSON) Equal(o attr.Value) bool {
+	other, ok := o.(NormalizedJSON)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether the new value holds the same json content as v. Invalid json is never
+// semantically equal, it is reported by ValidateAttribute instead.
+func (v NormalizedJSON) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NormalizedJSON)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	equal, err := JSONSemanticallyEqual(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return equal, diags
+}
+
+// ValidateAttribute checks that a known value is a valid json document.
+func (v NormalizedJSON) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			fmt.Sprintf("A string value was provided that is not valid JSON, given value: %s", v.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+
+package tfvalues
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizedJSONStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  NormalizedJSON
+		new      NormalizedJSON
+		expected bool
+	}{
+		{
+			name:     "formatting",
+			current:  NewNormalizedJSONValue(`{"ip":"10.0.0.1","ttl":300}`),
+			new:      NewNormalizedJSONValue("{\n  \"ttl\": 3.0e2,\n  \"ip\": \"10.0.0.1\"\n}"),
+			expected: true,
+		},
+		{
+			name:     "different_content",
+			current:  NewNormalizedJSONValue(`{"ip":"10.0.0.1"}`),
+			new:      NewNormalizedJSONValue(`{"ip":"10.0.0.2"}`),
+			expected: false,
+		},
+		{
+			name:     "invalid_json",
+			current:  NewNormalizedJSONValue(`{"ip":"10.0.0.1"}`),
+			new:      NewNormalizedJSONValue(`not json`),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, diags := test.current.StringSemanticEquals(context.Background(), test.new)
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if result != test.expected {
+				t.Errorf("Expected %t, got %t", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestNormalizedJSONValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name  string
+		value NormalizedJSON
+		err   bool
+	}{
+		{name: "valid", value: NewNormalizedJSONValue(`{"ip":"10.0.0.1"}`)},
+		{name: "null", value: NewNormalizedJSONNull()},
+		{name: "unknown", value: NewNormalizedJSONUnknown()},
+		{name: "invalid", value: NewNormalizedJSONValue(`{"ip":`), err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := xattr.ValidateAttributeResponse{}
+			test.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("deployed_item")}, &resp)
+
+			if resp.Diagnostics.HasError() != test.err {
+				t.Errorf("Expected error %t, got %v", test.err, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNormalizedJSONTypeValueFromTerraform(t *testing.T) {
+	value, err := NormalizedJSONType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, `{"ip":"10.0.0.1"}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !value.Equal(NewNormalizedJSONValue(`{"ip":"10.0.0.1"}`)) {
+		t.Errorf("Expected NormalizedJSON value, got %#v", value)
+	}
+}
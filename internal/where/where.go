@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+
+// Package where evaluates client-side conditions against the decoded json of NetOrca objects.
+//
+// A condition's path walks the object's json: keys are separated by dots, array elements are selected with [n] and
+// every element or value of an array or object with [*] (or a bare *). Keys containing dots or brackets are quoted
+// as ["a.b"]. An optional leading "$." is ignored, e.g. "$.declaration.records[*].type".
+package where
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	OpEquals    = "equals"
+	OpNotEquals = "not_equals"
+	OpIn        = "in"
+	OpNotIn     = "not_in"
+	OpContains  = "contains"
+	OpMatches   = "matches"
+	OpExists    = "exists"
+	OpNotExists = "not_exists"
+)
+
+// Operators lists every supported condition operator.
+var Operators = []string{OpEquals, OpNotEquals, OpIn, OpNotIn, OpContains, OpMatches, OpExists, OpNotExists}
+
+// Condition matches an object when the values found at Path satisfy Operator against Values.
+type Condition struct {
+	Path     string
+	Operator string
+	Values   []string
+
+	segments []segment
+	pattern  *regexp.Regexp
+}
+
+type segment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// NewCondition parses and validates a condition, an empty operator defaults to equals. Values are compared with the
+// text form of the json values found, i.e. strings unquoted, numbers and booleans as written, null as "null" and
+// objects or arrays as compact json.
+func NewCondition(path string, operator string, values []string) (Condition, error) {
+	c := Condition{Path: path, Operator: operator, Values: values}
+
+	if c.Operator == "" {
+		c.Operator = OpEquals
+	}
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return Condition{}, err
+	}
+	c.segments = segments
+
+	switch c.Operator {
+	case OpEquals, OpNotEquals, OpContains, OpMatches:
+		if len(values) != 1 {
+			return Condition{}, fmt.Errorf("operator %s on path %s needs exactly one value, got %d", c.Operator, path, len(values))
+		}
+	case OpIn, OpNotIn:
+		if len(values) == 0 {
+			return Condition{}, fmt.Errorf("operator %s on path %s needs at least one value", c.Operator, path)
+		}
+	case OpExists, OpNotExists:
+		if len(values) != 0 {
+			return Condition{}, fmt.Errorf("operator %s on path %s takes no value", c.Operator, path)
+		}
+	default:
+		return Condition{}, fmt.Errorf("unknown operator %s, expected one of: %s", c.Operator, strings.Join(Operators, ", "))
+	}
+
+	if c.Operator == OpMatches {
+		c.pattern, err = regexp.Compile(values[0])
+		if err != nil {
+			return Condition{}, fmt.Errorf("invalid regular expression for path %s: %s", path, err.Error())
+		}
+	}
+
+	return c, nil
+}
+
+// Match reports whether the decoded json document satisfies the condition. A path matching several values satisfies
+// equals, in, contains and matches when any of them does, and not_equals and not_in only when none of them do.
+func (c Condition) Match(doc interface{}) bool {
+	found := resolve(doc, c.segments)
+
+	switch c.Operator {
+	case OpExists:
+		return len(found) > 0
+	case OpNotExists:
+		return len(found) == 0
+	case OpNotEquals, OpNotIn:
+		for _, v := range found {
+			if c.in(v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, v := range found {
+		switch c.Operator {
+		case OpEquals, OpIn:
+			if c.in(v) {
+				return true
+			}
+		case OpContains:
+			if contains(v, c.Values[0]) {
+				return true
+			}
+		case OpMatches:
+			if s, ok := v.(string); ok && c.pattern.MatchString(s) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Filter returns the items whose json satisfies every condition, in their original order.
+func Filter[T any](items []T, conditions []Condition) ([]T, error) {
+	if len(conditions) == 0 {
+		return items, nil
+	}
+
+	filtered := []T{}
+	for _, item := range items {
+		doc, err := decode(item)
+		if err != nil {
+			return nil, err
+		}
+
+		matched := true
+		for _, c := range conditions {
+			if !c.Match(doc) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered, nil
+}
+
+// Lookup returns the values found at path in the json of item. Numbers are returned as json.Number.
+func Lookup[T any](item T, path string) ([]interface{}, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := decode(item)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolve(doc, segments), nil
+}
+
+// Text returns the text form of a json value, as compared by conditions.
+func Text(v interface{}) string {
+	return toText(v)
+}
+
+func (c Condition) in(v interface{}) bool {
+	text := toText(v)
+	for _, value := range c.Values {
+		if text == value {
+			return true
+		}
+	}
+	return false
+}
+
+// contains matches a substring of a string or an element of an array.
+func contains(v interface{}, value string) bool {
+	switch t := v.(type) {
+	case string:
+		return strings.Contains(t, value)
+	case []interface{}:
+		for _, e := range t {
+			if toText(e) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func resolve(doc interface{}, segments []segment) []interface{} {
+	current := []interface{}{doc}
+
+	for _, s := range segments {
+		next := []interface{}{}
+		for _, v := range current {
+			switch t := v.(type) {
+			case map[string]interface{}:
+				if s.wildcard {
+					for _, e := range t {
+						next = append(next, e)
+					}
+				} else if !s.isIndex {
+					if e, ok := t[s.key]; ok {
+						next = append(next, e)
+					}
+				}
+			case []interface{}:
+				if s.wildcard {
+					next = append(next, t...)
+				} else if s.isIndex && s.index >= 0 && s.index < len(t) {
+					next = append(next, t[s.index])
+				}
+			}
+		}
+		current = next
+	}
+
+	return current
+}
+
+func parsePath(path string) ([]segment, error) {
+	p := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if p == "" {
+		return nil, fmt.Errorf("invalid path %q: path is empty", path)
+	}
+
+	var segments []segment
+	for len(p) > 0 {
+		switch p[0] {
+		case '[':
+			if strings.HasPrefix(p, "[\"") {
+				end := closingQuote(p)
+				if end < 0 {
+					return nil, fmt.Errorf("invalid path %q: unclosed quoted key", path)
+				}
+				key, err := strconv.Unquote(p[1 : end-1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: %s", path, err.Error())
+				}
+				segments = append(segments, segment{key: key})
+				p = p[end:]
+				continue
+			}
+
+			end := strings.Index(p, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed [", path)
+			}
+			inner := p[1:end]
+			if inner == "*" {
+				segments = append(segments, segment{wildcard: true})
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: [%s] is not an index, * or quoted key", path, inner)
+				}
+				segments = append(segments, segment{index: index, isIndex: true})
+			}
+			p = p[end+1:]
+		case '.':
+			p = p[1:]
+			if p == "" || p[0] == '.' {
+				return nil, fmt.Errorf("invalid path %q: empty key", path)
+			}
+		default:
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			key := p[:end]
+			if key == "*" {
+				segments = append(segments, segment{wildcard: true})
+			} else {
+				segments = append(segments, segment{key: key})
+			}
+			p = p[end:]
+		}
+	}
+
+	return segments, nil
+}
+
+// closingQuote returns the position after the ] closing a ["..."] segment at the start of p, or -1.
+func closingQuote(p string) int {
+	for i := 2; i < len(p); i++ {
+		switch p[i] {
+		case '\\':
+			i++
+		case '"':
+			if i+1 < len(p) && p[i+1] == ']' {
+				return i + 2
+			}
+			return -1
+		}
+	}
+	return -1
+}
+
+func toText(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return t
+	case json.Number:
+		return t.String()
+	case bool:
+		return strconv.FormatBool(t)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func decode(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
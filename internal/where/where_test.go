@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+
+package where
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testItem struct {
+	Id          int64                  `json:"id"`
+	Declaration map[string]interface{} `json:"declaration"`
+}
+
+var testItems = []testItem{
+	{
+		Id: 1,
+		Declaration: map[string]interface{}{
+			"zone":    "example.com",
+			"ttl":     300,
+			"records": []interface{}{map[string]interface{}{"type": "A"}, map[string]interface{}{"type": "AAAA"}},
+			"tags":    []interface{}{"prod", "web"},
+			"a.b":     true,
+		},
+	},
+	{
+		Id: 2,
+		Declaration: map[string]interface{}{
+			"zone":    "example.org",
+			"ttl":     60,
+			"records": []interface{}{map[string]interface{}{"type": "CNAME"}},
+			"tags":    []interface{}{"dev"},
+		},
+	},
+	{
+		Id:          3,
+		Declaration: map[string]interface{}{"zone": nil},
+	},
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		operator string
+		values   []string
+		expected []int64
+	}{
+		{"equals_default", "declaration.zone", "", []string{"example.com"}, []int64{1}},
+		{"equals_jsonpath_root", "$.declaration.zone", "equals", []string{"example.org"}, []int64{2}},
+		{"equals_number", "declaration.ttl", "equals", []string{"300"}, []int64{1}},
+		{"equals_null", "declaration.zone", "equals", []string{"null"}, []int64{3}},
+		{"not_equals", "declaration.zone", "not_equals", []string{"example.com"}, []int64{2, 3}},
+		{"in", "declaration.ttl", "in", []string{"60", "300"}, []int64{1, 2}},
+		{"not_in", "id", "not_in", []string{"1", "2"}, []int64{3}},
+		{"contains_substring", "declaration.zone", "contains", []string{".org"}, []int64{2}},
+		{"contains_element", "declaration.tags", "contains", []string{"web"}, []int64{1}},
+		{"matches", "declaration.zone", "matches", []string{`^example\.(com|net)$`}, []int64{1}},
+		{"wildcard", "declaration.records[*].type", "equals", []string{"AAAA"}, []int64{1}},
+		{"index", "declaration.records[0].type", "equals", []string{"CNAME"}, []int64{2}},
+		{"quoted_key", `declaration["a.b"]`, "equals", []string{"true"}, []int64{1}},
+		{"exists", "declaration.records", "exists", nil, []int64{1, 2}},
+		{"not_exists", "declaration.records", "not_exists", nil, []int64{3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			condition, err := NewCondition(test.path, test.operator, test.values)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			result, err := Filter(testItems, []Condition{condition})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			ids := []int64{}
+			for _, v := range result {
+				ids = append(ids, v.Id)
+			}
+			if len(ids) != len(test.expected) {
+				t.Fatalf("Expected ids %v, got %v", test.expected, ids)
+			}
+			for i := range ids {
+				if ids[i] != test.expected[i] {
+					t.Fatalf("Expected ids %v, got %v", test.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterAllConditions(t *testing.T) {
+	zone, _ := NewCondition("declaration.zone", "matches", []string{"^example"})
+	ttl, _ := NewCondition("declaration.ttl", "equals", []string{"60"})
+
+	result, err := Filter(testItems, []Condition{zone, ttl})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result) != 1 || result[0].Id != 2 {
+		t.Errorf("Expected only item 2, got %+v", result)
+	}
+}
+
+func TestNewConditionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		operator string
+		values   []string
+		errMsg   string
+	}{
+		{"empty_path", "$", "exists", nil, `invalid path "$": path is empty`},
+		{"empty_key", "declaration..zone", "exists", nil, `invalid path "declaration..zone": empty key`},
+		{"bad_index", "records[a]", "exists", nil, `invalid path "records[a]": [a] is not an index, * or quoted key`},
+		{"unclosed", "records[0", "exists", nil, `invalid path "records[0": unclosed [`},
+		{"unknown_operator", "zone", "like", []string{"a"}, "unknown operator like, expected one of: equals, not_equals, in, not_in, contains, matches, exists, not_exists"},
+		{"missing_value", "zone", "equals", nil, "operator equals on path zone needs exactly one value, got 0"},
+		{"missing_values", "zone", "in", nil, "operator in on path zone needs at least one value"},
+		{"unexpected_value", "zone", "exists", []string{"a"}, "operator exists on path zone takes no value"},
+		{"bad_regex", "zone", "matches", []string{"("}, "invalid regular expression for path zone: error parsing regexp: missing closing ): `(`"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewCondition(test.path, test.operator, test.values)
+			if err == nil {
+				t.Fatalf("Expected error %s, got nil", test.errMsg)
+			}
+			if err.Error() != test.errMsg {
+				t.Errorf("Expected error %s, got %s", test.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{path: "declaration.zone", expected: []string{"example.com"}},
+		{path: "declaration.records[*].type", expected: []string{"A", "AAAA"}},
+		{path: "declaration.ttl", expected: []string{"300"}},
+		{path: "declaration.missing", expected: []string{}},
+	}
+
+	for _, test := range tests {
+		found, err := Lookup(testItems[0], test.path)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.path, err)
+		}
+
+		result := []string{}
+		for _, v := range found {
+			result = append(result, Text(v))
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.path, test.expected, result)
+		}
+	}
+
+	if _, err := Lookup(testItems[0], "declaration..zone"); err == nil {
+		t.Errorf("Expected an error for an invalid path")
+	}
+}